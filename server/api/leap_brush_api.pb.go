@@ -268,6 +268,367 @@ func (BrushStrokeProto_BrushType) EnumDescriptor() ([]byte, []int) {
 	return file_leap_brush_api_proto_rawDescGZIP(), []int{10, 0}
 }
 
+type TextNoteProto_BillboardMode int32
+
+const (
+	// The text note is oriented by its pose.
+	TextNoteProto_NONE TextNoteProto_BillboardMode = 0
+	// The text note always rotates to face the viewer.
+	TextNoteProto_FACE_USER TextNoteProto_BillboardMode = 1
+	// The text note rotates around its vertical axis to face the viewer.
+	TextNoteProto_FACE_USER_VERTICAL TextNoteProto_BillboardMode = 2
+)
+
+// Enum value maps for TextNoteProto_BillboardMode.
+var (
+	TextNoteProto_BillboardMode_name = map[int32]string{
+		0: "NONE",
+		1: "FACE_USER",
+		2: "FACE_USER_VERTICAL",
+	}
+	TextNoteProto_BillboardMode_value = map[string]int32{
+		"NONE":               0,
+		"FACE_USER":          1,
+		"FACE_USER_VERTICAL": 2,
+	}
+)
+
+func (x TextNoteProto_BillboardMode) Enum() *TextNoteProto_BillboardMode {
+	p := new(TextNoteProto_BillboardMode)
+	*p = x
+	return p
+}
+
+func (x TextNoteProto_BillboardMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TextNoteProto_BillboardMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_leap_brush_api_proto_enumTypes[5].Descriptor()
+}
+
+func (TextNoteProto_BillboardMode) Type() protoreflect.EnumType {
+	return &file_leap_brush_api_proto_enumTypes[5]
+}
+
+func (x TextNoteProto_BillboardMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TextNoteProto_BillboardMode.Descriptor instead.
+func (TextNoteProto_BillboardMode) EnumDescriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type PrimitiveProto_Shape int32
+
+const (
+	// A line between two control points: start and end.
+	PrimitiveProto_LINE PrimitiveProto_Shape = 0
+	// An arrow between two control points: tail and head.
+	PrimitiveProto_ARROW PrimitiveProto_Shape = 1
+	// A line between two control points with its length displayed.
+	PrimitiveProto_MEASURED_LINE PrimitiveProto_Shape = 2
+	// A box between two control points at opposite corners, aligned to the axes of the primitive's transform.
+	PrimitiveProto_BOX PrimitiveProto_Shape = 3
+	// A sphere with two control points: center, and a point on the surface.
+	PrimitiveProto_SPHERE PrimitiveProto_Shape = 4
+	// A cylinder with three control points: base center, top center, and a point on the rim of the base.
+	PrimitiveProto_CYLINDER PrimitiveProto_Shape = 5
+)
+
+// Enum value maps for PrimitiveProto_Shape.
+var (
+	PrimitiveProto_Shape_name = map[int32]string{
+		0: "LINE",
+		1: "ARROW",
+		2: "MEASURED_LINE",
+		3: "BOX",
+		4: "SPHERE",
+		5: "CYLINDER",
+	}
+	PrimitiveProto_Shape_value = map[string]int32{
+		"LINE":          0,
+		"ARROW":         1,
+		"MEASURED_LINE": 2,
+		"BOX":           3,
+		"SPHERE":        4,
+		"CYLINDER":      5,
+	}
+)
+
+func (x PrimitiveProto_Shape) Enum() *PrimitiveProto_Shape {
+	p := new(PrimitiveProto_Shape)
+	*p = x
+	return p
+}
+
+func (x PrimitiveProto_Shape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrimitiveProto_Shape) Descriptor() protoreflect.EnumDescriptor {
+	return file_leap_brush_api_proto_enumTypes[6].Descriptor()
+}
+
+func (PrimitiveProto_Shape) Type() protoreflect.EnumType {
+	return &file_leap_brush_api_proto_enumTypes[6]
+}
+
+func (x PrimitiveProto_Shape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrimitiveProto_Shape.Descriptor instead.
+func (PrimitiveProto_Shape) EnumDescriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type RoomSettingsProto_UnitSystem int32
+
+const (
+	// Lengths in meters and centimeters, areas in square meters.
+	RoomSettingsProto_METRIC RoomSettingsProto_UnitSystem = 0
+	// Lengths in feet and inches, areas in square feet.
+	RoomSettingsProto_IMPERIAL RoomSettingsProto_UnitSystem = 1
+)
+
+// Enum value maps for RoomSettingsProto_UnitSystem.
+var (
+	RoomSettingsProto_UnitSystem_name = map[int32]string{
+		0: "METRIC",
+		1: "IMPERIAL",
+	}
+	RoomSettingsProto_UnitSystem_value = map[string]int32{
+		"METRIC":   0,
+		"IMPERIAL": 1,
+	}
+)
+
+func (x RoomSettingsProto_UnitSystem) Enum() *RoomSettingsProto_UnitSystem {
+	p := new(RoomSettingsProto_UnitSystem)
+	*p = x
+	return p
+}
+
+func (x RoomSettingsProto_UnitSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomSettingsProto_UnitSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_leap_brush_api_proto_enumTypes[7].Descriptor()
+}
+
+func (RoomSettingsProto_UnitSystem) Type() protoreflect.EnumType {
+	return &file_leap_brush_api_proto_enumTypes[7]
+}
+
+func (x RoomSettingsProto_UnitSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomSettingsProto_UnitSystem.Descriptor instead.
+func (RoomSettingsProto_UnitSystem) EnumDescriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type EraseRegionRequest_Shape int32
+
+const (
+	EraseRegionRequest_SPHERE  EraseRegionRequest_Shape = 0
+	EraseRegionRequest_BOX     EraseRegionRequest_Shape = 1
+	EraseRegionRequest_CAPSULE EraseRegionRequest_Shape = 2
+)
+
+// Enum value maps for EraseRegionRequest_Shape.
+var (
+	EraseRegionRequest_Shape_name = map[int32]string{
+		0: "SPHERE",
+		1: "BOX",
+		2: "CAPSULE",
+	}
+	EraseRegionRequest_Shape_value = map[string]int32{
+		"SPHERE":  0,
+		"BOX":     1,
+		"CAPSULE": 2,
+	}
+)
+
+func (x EraseRegionRequest_Shape) Enum() *EraseRegionRequest_Shape {
+	p := new(EraseRegionRequest_Shape)
+	*p = x
+	return p
+}
+
+func (x EraseRegionRequest_Shape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EraseRegionRequest_Shape) Descriptor() protoreflect.EnumDescriptor {
+	return file_leap_brush_api_proto_enumTypes[8].Descriptor()
+}
+
+func (EraseRegionRequest_Shape) Type() protoreflect.EnumType {
+	return &file_leap_brush_api_proto_enumTypes[8]
+}
+
+func (x EraseRegionRequest_Shape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EraseRegionRequest_Shape.Descriptor instead.
+func (EraseRegionRequest_Shape) EnumDescriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type ModelCatalogEntryProto_Source int32
+
+const (
+	// The model was uploaded by a user.
+	ModelCatalogEntryProto_UPLOADED ModelCatalogEntryProto_Source = 0
+	// The model was found in the server's configured model catalog directory.
+	ModelCatalogEntryProto_CATALOG_DIR ModelCatalogEntryProto_Source = 1
+)
+
+// Enum value maps for ModelCatalogEntryProto_Source.
+var (
+	ModelCatalogEntryProto_Source_name = map[int32]string{
+		0: "UPLOADED",
+		1: "CATALOG_DIR",
+	}
+	ModelCatalogEntryProto_Source_value = map[string]int32{
+		"UPLOADED":    0,
+		"CATALOG_DIR": 1,
+	}
+)
+
+func (x ModelCatalogEntryProto_Source) Enum() *ModelCatalogEntryProto_Source {
+	p := new(ModelCatalogEntryProto_Source)
+	*p = x
+	return p
+}
+
+func (x ModelCatalogEntryProto_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModelCatalogEntryProto_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_leap_brush_api_proto_enumTypes[9].Descriptor()
+}
+
+func (ModelCatalogEntryProto_Source) Type() protoreflect.EnumType {
+	return &file_leap_brush_api_proto_enumTypes[9]
+}
+
+func (x ModelCatalogEntryProto_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModelCatalogEntryProto_Source.Descriptor instead.
+func (ModelCatalogEntryProto_Source) EnumDescriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{64, 0}
+}
+
+type ServerEventProto_Type int32
+
+const (
+	// A user opened a listening stream.
+	ServerEventProto_USER_CONNECTED ServerEventProto_Type = 0
+	// A user's listening stream closed.
+	ServerEventProto_USER_DISCONNECTED ServerEventProto_Type = 1
+	// The server received the first state update from a user.
+	ServerEventProto_USER_JOINED ServerEventProto_Type = 2
+	// A user's state was removed after they timed out or were kicked.
+	ServerEventProto_USER_LEFT              ServerEventProto_Type = 3
+	ServerEventProto_BRUSH_STROKE_ADDED     ServerEventProto_Type = 4
+	ServerEventProto_BRUSH_STROKE_REMOVED   ServerEventProto_Type = 5
+	ServerEventProto_EXTERNAL_MODEL_ADDED   ServerEventProto_Type = 6
+	ServerEventProto_EXTERNAL_MODEL_REMOVED ServerEventProto_Type = 7
+	ServerEventProto_TEXT_NOTE_ADDED        ServerEventProto_Type = 8
+	ServerEventProto_TEXT_NOTE_REMOVED      ServerEventProto_Type = 9
+	ServerEventProto_PRIMITIVE_ADDED        ServerEventProto_Type = 10
+	ServerEventProto_PRIMITIVE_REMOVED      ServerEventProto_Type = 11
+	ServerEventProto_MEASUREMENT_ADDED      ServerEventProto_Type = 12
+	ServerEventProto_MEASUREMENT_REMOVED    ServerEventProto_Type = 13
+	ServerEventProto_COMMENT_ADDED          ServerEventProto_Type = 14
+	ServerEventProto_COMMENT_REMOVED        ServerEventProto_Type = 15
+	// An admin sent a message to all connected users.
+	ServerEventProto_BROADCAST ServerEventProto_Type = 16
+	// A user's headset battery became low while not charging.
+	ServerEventProto_BATTERY_LOW ServerEventProto_Type = 17
+)
+
+// Enum value maps for ServerEventProto_Type.
+var (
+	ServerEventProto_Type_name = map[int32]string{
+		0:  "USER_CONNECTED",
+		1:  "USER_DISCONNECTED",
+		2:  "USER_JOINED",
+		3:  "USER_LEFT",
+		4:  "BRUSH_STROKE_ADDED",
+		5:  "BRUSH_STROKE_REMOVED",
+		6:  "EXTERNAL_MODEL_ADDED",
+		7:  "EXTERNAL_MODEL_REMOVED",
+		8:  "TEXT_NOTE_ADDED",
+		9:  "TEXT_NOTE_REMOVED",
+		10: "PRIMITIVE_ADDED",
+		11: "PRIMITIVE_REMOVED",
+		12: "MEASUREMENT_ADDED",
+		13: "MEASUREMENT_REMOVED",
+		14: "COMMENT_ADDED",
+		15: "COMMENT_REMOVED",
+		16: "BROADCAST",
+		17: "BATTERY_LOW",
+	}
+	ServerEventProto_Type_value = map[string]int32{
+		"USER_CONNECTED":         0,
+		"USER_DISCONNECTED":      1,
+		"USER_JOINED":            2,
+		"USER_LEFT":              3,
+		"BRUSH_STROKE_ADDED":     4,
+		"BRUSH_STROKE_REMOVED":   5,
+		"EXTERNAL_MODEL_ADDED":   6,
+		"EXTERNAL_MODEL_REMOVED": 7,
+		"TEXT_NOTE_ADDED":        8,
+		"TEXT_NOTE_REMOVED":      9,
+		"PRIMITIVE_ADDED":        10,
+		"PRIMITIVE_REMOVED":      11,
+		"MEASUREMENT_ADDED":      12,
+		"MEASUREMENT_REMOVED":    13,
+		"COMMENT_ADDED":          14,
+		"COMMENT_REMOVED":        15,
+		"BROADCAST":              16,
+		"BATTERY_LOW":            17,
+	}
+)
+
+func (x ServerEventProto_Type) Enum() *ServerEventProto_Type {
+	p := new(ServerEventProto_Type)
+	*p = x
+	return p
+}
+
+func (x ServerEventProto_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerEventProto_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_leap_brush_api_proto_enumTypes[10].Descriptor()
+}
+
+func (ServerEventProto_Type) Type() protoreflect.EnumType {
+	return &file_leap_brush_api_proto_enumTypes[10]
+}
+
+func (x ServerEventProto_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServerEventProto_Type.Descriptor instead.
+func (ServerEventProto_Type) EnumDescriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{91, 0}
+}
+
 type Vector3Proto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1033,6 +1394,16 @@ type BrushStrokeProto struct {
 	// The alpha value between 0 and 255 for the segmented dimmer fill of this brush stroke (optional for incremental
 	// updates)
 	FillDimmerA uint32 `protobuf:"varint,9,opt,name=fill_dimmer_a,json=fillDimmerA,proto3" json:"fill_dimmer_a,omitempty"`
+	// The transform applied to all brush poses, relative to the parent if set or otherwise to the provided spatial
+	// anchor (optional for incremental updates). Unset is treated as the identity transform.
+	Transform *TransformProto `protobuf:"bytes,10,opt,name=transform,proto3" json:"transform,omitempty"`
+	// The layer id that this brush stroke belongs to, or empty for the default layer (optional for incremental
+	// updates).
+	LayerId string `protobuf:"bytes,11,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	// Optional id of a brush stroke or 3D model on the same spatial anchor that this brush stroke is attached to, so that
+	// it moves with its parent. Only read when the brush stroke is created; use a BrushStrokeTransformRequest to
+	// reparent it later.
+	ParentId string `protobuf:"bytes,12,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
 }
 
 func (x *BrushStrokeProto) Reset() {
@@ -1130,6 +1501,27 @@ func (x *BrushStrokeProto) GetFillDimmerA() uint32 {
 	return 0
 }
 
+func (x *BrushStrokeProto) GetTransform() *TransformProto {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
+}
+
+func (x *BrushStrokeProto) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
+	}
+	return ""
+}
+
+func (x *BrushStrokeProto) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
 // ExternalModelProto represents a new or updated 3D model
 type ExternalModelProto struct {
 	state         protoimpl.MessageState
@@ -1142,10 +1534,23 @@ type ExternalModelProto struct {
 	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	// The anchor id for the closest spatial anchor when this 3D model was created.
 	AnchorId string `protobuf:"bytes,3,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
-	// The current transform of this 3D model relative to the provided spatial anchor.
+	// The current transform of this 3D model relative to the parent if set or otherwise to the provided spatial anchor.
 	Transform *TransformProto `protobuf:"bytes,6,opt,name=transform,proto3" json:"transform,omitempty"`
 	// The last user identifier to modify this 3D model.
 	ModifiedByUserName string `protobuf:"bytes,5,opt,name=modified_by_user_name,json=modifiedByUserName,proto3" json:"modified_by_user_name,omitempty"`
+	// The layer id that this 3D model belongs to, or empty for the default layer. Only used when the 3D model is added;
+	// updates keep the existing layer, which is changed with a SetContentLayerRequest.
+	LayerId string `protobuf:"bytes,7,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	// The content hash of the model file in the server's asset store, or empty if the file is side-loaded on each
+	// device. Devices without the file can fetch it with the DownloadModel rpc.
+	ContentHash string `protobuf:"bytes,8,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Optional animation playback state for this 3D model. Updates without an animation keep the existing animation
+	// state, so pause an animation rather than clearing it.
+	Animation *ModelAnimationProto `protobuf:"bytes,9,opt,name=animation,proto3" json:"animation,omitempty"`
+	// Optional id of a brush stroke or 3D model on the same spatial anchor that this 3D model is attached to, so that
+	// it moves with its parent. Updates without a parent_id keep the existing parent; set it to empty to detach the 3D
+	// model from its parent.
+	ParentId *string `protobuf:"bytes,10,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
 }
 
 func (x *ExternalModelProto) Reset() {
@@ -1215,20 +1620,61 @@ func (x *ExternalModelProto) GetModifiedByUserName() string {
 	return ""
 }
 
-// RegisterDeviceRequest contains the initialization data for a device registering for updates with the server
-type RegisterDeviceRequest struct {
+func (x *ExternalModelProto) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
+	}
+	return ""
+}
+
+func (x *ExternalModelProto) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *ExternalModelProto) GetAnimation() *ModelAnimationProto {
+	if x != nil {
+		return x.Animation
+	}
+	return nil
+}
+
+func (x *ExternalModelProto) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+// ModelAnimationProto contains the animation playback state for a 3D model, shared by all users. While playing, the
+// current playback position in seconds is
+//
+//	position_seconds + (server_time_millis - position_server_time_millis) / 1000 * playback_rate
+//
+// where server_time_millis is the current server time, estimated from ServerStateResponse.server_time_millis.
+type ModelAnimationProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The user identifier
-	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	// The version string for the client
-	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
-}
-
-func (x *RegisterDeviceRequest) Reset() {
-	*x = RegisterDeviceRequest{}
+	// The name of the animation clip to play, or empty for the model's first clip.
+	ClipName string `protobuf:"bytes,1,opt,name=clip_name,json=clipName,proto3" json:"clip_name,omitempty"`
+	// Whether the animation is playing, or paused at position_seconds.
+	Playing bool `protobuf:"varint,2,opt,name=playing,proto3" json:"playing,omitempty"`
+	// The playback speed, where 1 is normal speed.
+	PlaybackRate float32 `protobuf:"fixed32,3,opt,name=playback_rate,json=playbackRate,proto3" json:"playback_rate,omitempty"`
+	// The playback position in seconds at position_server_time_millis.
+	PositionSeconds float32 `protobuf:"fixed32,4,opt,name=position_seconds,json=positionSeconds,proto3" json:"position_seconds,omitempty"`
+	// The server time in milliseconds since the unix epoch when playback was at position_seconds. Clients set this to
+	// zero when changing the animation state, and the server sets it to the current server time. Updates with a non-zero
+	// value leave the animation state unchanged.
+	PositionServerTimeMillis int64 `protobuf:"varint,5,opt,name=position_server_time_millis,json=positionServerTimeMillis,proto3" json:"position_server_time_millis,omitempty"`
+}
+
+func (x *ModelAnimationProto) Reset() {
+	*x = ModelAnimationProto{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_leap_brush_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1236,13 +1682,13 @@ func (x *RegisterDeviceRequest) Reset() {
 	}
 }
 
-func (x *RegisterDeviceRequest) String() string {
+func (x *ModelAnimationProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterDeviceRequest) ProtoMessage() {}
+func (*ModelAnimationProto) ProtoMessage() {}
 
-func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelAnimationProto) ProtoReflect() protoreflect.Message {
 	mi := &file_leap_brush_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1254,36 +1700,79 @@ func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterDeviceRequest.ProtoReflect.Descriptor instead.
-func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelAnimationProto.ProtoReflect.Descriptor instead.
+func (*ModelAnimationProto) Descriptor() ([]byte, []int) {
 	return file_leap_brush_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RegisterDeviceRequest) GetUserName() string {
+func (x *ModelAnimationProto) GetClipName() string {
 	if x != nil {
-		return x.UserName
+		return x.ClipName
 	}
 	return ""
 }
 
-func (x *RegisterDeviceRequest) GetAppVersion() string {
+func (x *ModelAnimationProto) GetPlaying() bool {
 	if x != nil {
-		return x.AppVersion
+		return x.Playing
 	}
-	return ""
+	return false
 }
 
-// BrushStrokeAddRequest represents a single brush stroke to be added or modified
-type BrushStrokeAddRequest struct {
+func (x *ModelAnimationProto) GetPlaybackRate() float32 {
+	if x != nil {
+		return x.PlaybackRate
+	}
+	return 0
+}
+
+func (x *ModelAnimationProto) GetPositionSeconds() float32 {
+	if x != nil {
+		return x.PositionSeconds
+	}
+	return 0
+}
+
+func (x *ModelAnimationProto) GetPositionServerTimeMillis() int64 {
+	if x != nil {
+		return x.PositionServerTimeMillis
+	}
+	return 0
+}
+
+// TextNoteProto represents a new or updated text annotation or sticky note
+type TextNoteProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BrushStroke *BrushStrokeProto `protobuf:"bytes,1,opt,name=brush_stroke,json=brushStroke,proto3" json:"brush_stroke,omitempty"`
-}
-
-func (x *BrushStrokeAddRequest) Reset() {
-	*x = BrushStrokeAddRequest{}
+	// The identifier of this text note instance.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user identifier for the creator of this text note.
+	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The anchor id for the closest spatial anchor when this text note was created.
+	AnchorId string `protobuf:"bytes,3,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The text contents of this text note.
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// The font size in meters.
+	FontSize float32 `protobuf:"fixed32,5,opt,name=font_size,json=fontSize,proto3" json:"font_size,omitempty"`
+	// The color of the text.
+	TextColorRgba uint32 `protobuf:"varint,6,opt,name=text_color_rgba,json=textColorRgba,proto3" json:"text_color_rgba,omitempty"`
+	// The background color of the note, or fully transparent for a text annotation without a background.
+	BackgroundColorRgba uint32 `protobuf:"varint,7,opt,name=background_color_rgba,json=backgroundColorRgba,proto3" json:"background_color_rgba,omitempty"`
+	// How this text note is oriented relative to the viewer.
+	BillboardMode TextNoteProto_BillboardMode `protobuf:"varint,8,opt,name=billboard_mode,json=billboardMode,proto3,enum=leapbrush.TextNoteProto_BillboardMode" json:"billboard_mode,omitempty"`
+	// The current pose of this text note relative to the provided spatial anchor.
+	Pose *PoseProto `protobuf:"bytes,9,opt,name=pose,proto3" json:"pose,omitempty"`
+	// The layer id that this text note belongs to, or empty for the default layer. Only used when the text note is added;
+	// updates keep the existing layer, which is changed with a SetContentLayerRequest.
+	LayerId string `protobuf:"bytes,10,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	// The last user identifier to modify this text note.
+	ModifiedByUserName string `protobuf:"bytes,11,opt,name=modified_by_user_name,json=modifiedByUserName,proto3" json:"modified_by_user_name,omitempty"`
+}
+
+func (x *TextNoteProto) Reset() {
+	*x = TextNoteProto{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_leap_brush_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1291,13 +1780,13 @@ func (x *BrushStrokeAddRequest) Reset() {
 	}
 }
 
-func (x *BrushStrokeAddRequest) String() string {
+func (x *TextNoteProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrushStrokeAddRequest) ProtoMessage() {}
+func (*TextNoteProto) ProtoMessage() {}
 
-func (x *BrushStrokeAddRequest) ProtoReflect() protoreflect.Message {
+func (x *TextNoteProto) ProtoReflect() protoreflect.Message {
 	mi := &file_leap_brush_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1309,100 +1798,121 @@ func (x *BrushStrokeAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrushStrokeAddRequest.ProtoReflect.Descriptor instead.
-func (*BrushStrokeAddRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TextNoteProto.ProtoReflect.Descriptor instead.
+func (*TextNoteProto) Descriptor() ([]byte, []int) {
 	return file_leap_brush_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BrushStrokeAddRequest) GetBrushStroke() *BrushStrokeProto {
+func (x *TextNoteProto) GetId() string {
 	if x != nil {
-		return x.BrushStroke
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// BrushStrokeRemoveRequest identifies a single brush stroke to be removed
-type BrushStrokeRemoveRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TextNoteProto) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
 
-	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+func (x *TextNoteProto) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
 }
 
-func (x *BrushStrokeRemoveRequest) Reset() {
-	*x = BrushStrokeRemoveRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TextNoteProto) GetText() string {
+	if x != nil {
+		return x.Text
 	}
+	return ""
 }
 
-func (x *BrushStrokeRemoveRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TextNoteProto) GetFontSize() float32 {
+	if x != nil {
+		return x.FontSize
+	}
+	return 0
 }
 
-func (*BrushStrokeRemoveRequest) ProtoMessage() {}
+func (x *TextNoteProto) GetTextColorRgba() uint32 {
+	if x != nil {
+		return x.TextColorRgba
+	}
+	return 0
+}
 
-func (x *BrushStrokeRemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TextNoteProto) GetBackgroundColorRgba() uint32 {
+	if x != nil {
+		return x.BackgroundColorRgba
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use BrushStrokeRemoveRequest.ProtoReflect.Descriptor instead.
-func (*BrushStrokeRemoveRequest) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{14}
+func (x *TextNoteProto) GetBillboardMode() TextNoteProto_BillboardMode {
+	if x != nil {
+		return x.BillboardMode
+	}
+	return TextNoteProto_NONE
 }
 
-func (x *BrushStrokeRemoveRequest) GetId() string {
+func (x *TextNoteProto) GetPose() *PoseProto {
 	if x != nil {
-		return x.Id
+		return x.Pose
+	}
+	return nil
+}
+
+func (x *TextNoteProto) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
 	}
 	return ""
 }
 
-func (x *BrushStrokeRemoveRequest) GetAnchorId() string {
+func (x *TextNoteProto) GetModifiedByUserName() string {
 	if x != nil {
-		return x.AnchorId
+		return x.ModifiedByUserName
 	}
 	return ""
 }
 
-// ExternalModelAddRequest represents a single 3D model to be added or modified
-type ExternalModelAddRequest struct {
+// PrimitiveStyleProto contains the drawing style for a geometric primitive
+type PrimitiveStyleProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Model *ExternalModelProto `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// The color of the primitive's lines or outline.
+	ColorRgba uint32 `protobuf:"varint,1,opt,name=color_rgba,json=colorRgba,proto3" json:"color_rgba,omitempty"`
+	// The width of the primitive's lines in meters.
+	LineWidth float32 `protobuf:"fixed32,2,opt,name=line_width,json=lineWidth,proto3" json:"line_width,omitempty"`
+	// The fill color for the primitive's surfaces, or fully transparent for a wireframe.
+	FillColorRgba uint32 `protobuf:"varint,3,opt,name=fill_color_rgba,json=fillColorRgba,proto3" json:"fill_color_rgba,omitempty"`
+	// Whether the primitive's lines are dashed.
+	Dashed bool `protobuf:"varint,4,opt,name=dashed,proto3" json:"dashed,omitempty"`
 }
 
-func (x *ExternalModelAddRequest) Reset() {
-	*x = ExternalModelAddRequest{}
+func (x *PrimitiveStyleProto) Reset() {
+	*x = PrimitiveStyleProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[15]
+		mi := &file_leap_brush_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalModelAddRequest) String() string {
+func (x *PrimitiveStyleProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalModelAddRequest) ProtoMessage() {}
+func (*PrimitiveStyleProto) ProtoMessage() {}
 
-func (x *ExternalModelAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[15]
+func (x *PrimitiveStyleProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,45 +1923,86 @@ func (x *ExternalModelAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalModelAddRequest.ProtoReflect.Descriptor instead.
-func (*ExternalModelAddRequest) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use PrimitiveStyleProto.ProtoReflect.Descriptor instead.
+func (*PrimitiveStyleProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ExternalModelAddRequest) GetModel() *ExternalModelProto {
+func (x *PrimitiveStyleProto) GetColorRgba() uint32 {
 	if x != nil {
-		return x.Model
+		return x.ColorRgba
 	}
-	return nil
+	return 0
 }
 
-// ExternalModelRemoveRequest identifies a single 3D model to be removed
-type ExternalModelRemoveRequest struct {
+func (x *PrimitiveStyleProto) GetLineWidth() float32 {
+	if x != nil {
+		return x.LineWidth
+	}
+	return 0
+}
+
+func (x *PrimitiveStyleProto) GetFillColorRgba() uint32 {
+	if x != nil {
+		return x.FillColorRgba
+	}
+	return 0
+}
+
+func (x *PrimitiveStyleProto) GetDashed() bool {
+	if x != nil {
+		return x.Dashed
+	}
+	return false
+}
+
+// PrimitiveProto represents a new or updated parametric geometric primitive, defined by a few control points
+type PrimitiveProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
-}
-
-func (x *ExternalModelRemoveRequest) Reset() {
-	*x = ExternalModelRemoveRequest{}
+	// The identifier of this primitive instance.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user identifier for the creator of this primitive.
+	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The anchor id for the closest spatial anchor when this primitive was created.
+	AnchorId string `protobuf:"bytes,3,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The shape of this primitive.
+	Shape PrimitiveProto_Shape `protobuf:"varint,4,opt,name=shape,proto3,enum=leapbrush.PrimitiveProto_Shape" json:"shape,omitempty"`
+	// The control points defining this primitive, in the space of the primitive's transform.
+	ControlPoint []*Vector3Proto `protobuf:"bytes,5,rep,name=control_point,json=controlPoint,proto3" json:"control_point,omitempty"`
+	// The transform applied to all control points, relative to the provided spatial anchor. Unset is treated as the
+	// identity transform.
+	Transform *TransformProto `protobuf:"bytes,6,opt,name=transform,proto3" json:"transform,omitempty"`
+	// The drawing style for this primitive.
+	Style *PrimitiveStyleProto `protobuf:"bytes,7,opt,name=style,proto3" json:"style,omitempty"`
+	// The layer id that this primitive belongs to, or empty for the default layer. Only used when the primitive is added;
+	// updates keep the existing layer, which is changed with a SetContentLayerRequest.
+	LayerId string `protobuf:"bytes,8,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	// The last user identifier to modify this primitive.
+	ModifiedByUserName string `protobuf:"bytes,9,opt,name=modified_by_user_name,json=modifiedByUserName,proto3" json:"modified_by_user_name,omitempty"`
+	// The length in meters of a MEASURED_LINE, including the scale of the transform. Set by the server.
+	MeasuredLength float32 `protobuf:"fixed32,10,opt,name=measured_length,json=measuredLength,proto3" json:"measured_length,omitempty"`
+}
+
+func (x *PrimitiveProto) Reset() {
+	*x = PrimitiveProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[16]
+		mi := &file_leap_brush_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalModelRemoveRequest) String() string {
+func (x *PrimitiveProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalModelRemoveRequest) ProtoMessage() {}
+func (*PrimitiveProto) ProtoMessage() {}
 
-func (x *ExternalModelRemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[16]
+func (x *PrimitiveProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,142 +2013,110 @@ func (x *ExternalModelRemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalModelRemoveRequest.ProtoReflect.Descriptor instead.
-func (*ExternalModelRemoveRequest) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PrimitiveProto.ProtoReflect.Descriptor instead.
+func (*PrimitiveProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ExternalModelRemoveRequest) GetId() string {
+func (x *PrimitiveProto) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ExternalModelRemoveRequest) GetAnchorId() string {
+func (x *PrimitiveProto) GetUserName() string {
 	if x != nil {
-		return x.AnchorId
+		return x.UserName
 	}
 	return ""
 }
 
-// QueryUsersRequest contains request parameters for an rpc to list users connected to the server
-type QueryUsersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *QueryUsersRequest) Reset() {
-	*x = QueryUsersRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PrimitiveProto) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
 	}
+	return ""
 }
 
-func (x *QueryUsersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryUsersRequest) ProtoMessage() {}
-
-func (x *QueryUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PrimitiveProto) GetShape() PrimitiveProto_Shape {
+	if x != nil {
+		return x.Shape
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use QueryUsersRequest.ProtoReflect.Descriptor instead.
-func (*QueryUsersRequest) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{17}
+	return PrimitiveProto_LINE
 }
 
-// QueryUsersResponse contains the results list for currently connected users.
-type QueryUsersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// List of users connected to the server.
-	Results []*QueryUsersResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+func (x *PrimitiveProto) GetControlPoint() []*Vector3Proto {
+	if x != nil {
+		return x.ControlPoint
+	}
+	return nil
 }
 
-func (x *QueryUsersResponse) Reset() {
-	*x = QueryUsersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PrimitiveProto) GetTransform() *TransformProto {
+	if x != nil {
+		return x.Transform
 	}
+	return nil
 }
 
-func (x *QueryUsersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PrimitiveProto) GetStyle() *PrimitiveStyleProto {
+	if x != nil {
+		return x.Style
+	}
+	return nil
 }
 
-func (*QueryUsersResponse) ProtoMessage() {}
-
-func (x *QueryUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PrimitiveProto) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use QueryUsersResponse.ProtoReflect.Descriptor instead.
-func (*QueryUsersResponse) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{18}
+func (x *PrimitiveProto) GetModifiedByUserName() string {
+	if x != nil {
+		return x.ModifiedByUserName
+	}
+	return ""
 }
 
-func (x *QueryUsersResponse) GetResults() []*QueryUsersResponse_Result {
+func (x *PrimitiveProto) GetMeasuredLength() float32 {
 	if x != nil {
-		return x.Results
+		return x.MeasuredLength
 	}
-	return nil
+	return 0
 }
 
-// ServerInfoProto contians information about the server
-type ServerInfoProto struct {
+// RoomSettingsProto contains settings shared by all users connected to the server
+type RoomSettingsProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The version string for the server
-	ServerVersion string `protobuf:"bytes,1,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
-	// The minimum version string required for connecting to this server.
-	MinAppVersion string `protobuf:"bytes,2,opt,name=min_app_version,json=minAppVersion,proto3" json:"min_app_version,omitempty"`
+	// The unit system used for measurement labels.
+	UnitSystem RoomSettingsProto_UnitSystem `protobuf:"varint,1,opt,name=unit_system,json=unitSystem,proto3,enum=leapbrush.RoomSettingsProto_UnitSystem" json:"unit_system,omitempty"`
+	// The last user identifier to modify the room settings.
+	ModifiedByUserName string `protobuf:"bytes,2,opt,name=modified_by_user_name,json=modifiedByUserName,proto3" json:"modified_by_user_name,omitempty"`
 }
 
-func (x *ServerInfoProto) Reset() {
-	*x = ServerInfoProto{}
+func (x *RoomSettingsProto) Reset() {
+	*x = RoomSettingsProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[19]
+		mi := &file_leap_brush_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServerInfoProto) String() string {
+func (x *RoomSettingsProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerInfoProto) ProtoMessage() {}
+func (*RoomSettingsProto) ProtoMessage() {}
 
-func (x *ServerInfoProto) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[19]
+func (x *RoomSettingsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,62 +2127,84 @@ func (x *ServerInfoProto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerInfoProto.ProtoReflect.Descriptor instead.
-func (*ServerInfoProto) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RoomSettingsProto.ProtoReflect.Descriptor instead.
+func (*RoomSettingsProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ServerInfoProto) GetServerVersion() string {
+func (x *RoomSettingsProto) GetUnitSystem() RoomSettingsProto_UnitSystem {
 	if x != nil {
-		return x.ServerVersion
+		return x.UnitSystem
 	}
-	return ""
+	return RoomSettingsProto_METRIC
 }
 
-func (x *ServerInfoProto) GetMinAppVersion() string {
+func (x *RoomSettingsProto) GetModifiedByUserName() string {
 	if x != nil {
-		return x.MinAppVersion
+		return x.ModifiedByUserName
 	}
 	return ""
 }
 
-// ServerStateResponse contains a single response from the server containing state updates.
-type ServerStateResponse struct {
+// MeasurementProto represents a new or updated measurement annotation through two or more points. Lengths, areas and
+// angles are computed by the server whenever the points change.
+type MeasurementProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Optional list of user states that have changed since last update
-	UserState []*UserStateProto `protobuf:"bytes,1,rep,name=user_state,json=userState,proto3" json:"user_state,omitempty"`
-	// Optional list of brush strokes that have been added or modified since last update.
-	BrushStrokeAdd []*BrushStrokeAddRequest `protobuf:"bytes,2,rep,name=brush_stroke_add,json=brushStrokeAdd,proto3" json:"brush_stroke_add,omitempty"`
-	// Optional list of brush strokes that have been removed since last update.
-	BrushStrokeRemove []*BrushStrokeRemoveRequest `protobuf:"bytes,3,rep,name=brush_stroke_remove,json=brushStrokeRemove,proto3" json:"brush_stroke_remove,omitempty"`
-	// Optional list of 3D models that have been added or modified since last update.
-	ExternalModelAdd []*ExternalModelAddRequest `protobuf:"bytes,4,rep,name=external_model_add,json=externalModelAdd,proto3" json:"external_model_add,omitempty"`
-	// Optional list of 3D models that have been removed since last update.
-	ExternalModelRemove []*ExternalModelRemoveRequest `protobuf:"bytes,5,rep,name=external_model_remove,json=externalModelRemove,proto3" json:"external_model_remove,omitempty"`
-	// Optional information about the server
-	ServerInfo *ServerInfoProto `protobuf:"bytes,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
-}
-
-func (x *ServerStateResponse) Reset() {
-	*x = ServerStateResponse{}
+	// The identifier of this measurement instance.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user identifier for the creator of this measurement.
+	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The anchor id for the closest spatial anchor when this measurement was created.
+	AnchorId string `protobuf:"bytes,3,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The measured points relative to the provided spatial anchor, in meters.
+	Point []*Vector3Proto `protobuf:"bytes,4,rep,name=point,proto3" json:"point,omitempty"`
+	// Whether the last point connects back to the first, forming a polygon whose area is measured.
+	Closed bool `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	// The layer id that this measurement belongs to, or empty for the default layer. Only used when the measurement is
+	// added; updates keep the existing layer, which is changed with a SetContentLayerRequest.
+	LayerId string `protobuf:"bytes,6,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	// The last user identifier to modify this measurement.
+	ModifiedByUserName string `protobuf:"bytes,7,opt,name=modified_by_user_name,json=modifiedByUserName,proto3" json:"modified_by_user_name,omitempty"`
+	// The length in meters of each segment between consecutive points, including the closing segment for a closed
+	// measurement. Set by the server.
+	SegmentLength []float32 `protobuf:"fixed32,8,rep,packed,name=segment_length,json=segmentLength,proto3" json:"segment_length,omitempty"`
+	// The sum of all segment lengths in meters. Set by the server.
+	TotalLength float32 `protobuf:"fixed32,9,opt,name=total_length,json=totalLength,proto3" json:"total_length,omitempty"`
+	// The area in square meters enclosed by a closed measurement with at least three points. Set by the server.
+	Area float32 `protobuf:"fixed32,10,opt,name=area,proto3" json:"area,omitempty"`
+	// The angle in degrees at each point between its two adjacent segments. Only includes points with two adjacent
+	// segments, in point order. Set by the server.
+	AngleDegrees []float32 `protobuf:"fixed32,11,rep,packed,name=angle_degrees,json=angleDegrees,proto3" json:"angle_degrees,omitempty"`
+	// The unit system of the room when the labels were computed. Set by the server.
+	UnitSystem RoomSettingsProto_UnitSystem `protobuf:"varint,12,opt,name=unit_system,json=unitSystem,proto3,enum=leapbrush.RoomSettingsProto_UnitSystem" json:"unit_system,omitempty"`
+	// A display label for each entry of segment_length. Set by the server.
+	SegmentLengthLabel []string `protobuf:"bytes,13,rep,name=segment_length_label,json=segmentLengthLabel,proto3" json:"segment_length_label,omitempty"`
+	// A display label for total_length. Set by the server.
+	TotalLengthLabel string `protobuf:"bytes,14,opt,name=total_length_label,json=totalLengthLabel,proto3" json:"total_length_label,omitempty"`
+	// A display label for area, or empty if no area is measured. Set by the server.
+	AreaLabel string `protobuf:"bytes,15,opt,name=area_label,json=areaLabel,proto3" json:"area_label,omitempty"`
+}
+
+func (x *MeasurementProto) Reset() {
+	*x = MeasurementProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[20]
+		mi := &file_leap_brush_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServerStateResponse) String() string {
+func (x *MeasurementProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerStateResponse) ProtoMessage() {}
+func (*MeasurementProto) ProtoMessage() {}
 
-func (x *ServerStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[20]
+func (x *MeasurementProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1674,92 +2215,162 @@ func (x *ServerStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerStateResponse.ProtoReflect.Descriptor instead.
-func (*ServerStateResponse) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MeasurementProto.ProtoReflect.Descriptor instead.
+func (*MeasurementProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ServerStateResponse) GetUserState() []*UserStateProto {
+func (x *MeasurementProto) GetId() string {
 	if x != nil {
-		return x.UserState
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ServerStateResponse) GetBrushStrokeAdd() []*BrushStrokeAddRequest {
+func (x *MeasurementProto) GetUserName() string {
 	if x != nil {
-		return x.BrushStrokeAdd
+		return x.UserName
 	}
-	return nil
+	return ""
 }
 
-func (x *ServerStateResponse) GetBrushStrokeRemove() []*BrushStrokeRemoveRequest {
+func (x *MeasurementProto) GetAnchorId() string {
 	if x != nil {
-		return x.BrushStrokeRemove
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *MeasurementProto) GetPoint() []*Vector3Proto {
+	if x != nil {
+		return x.Point
 	}
 	return nil
 }
 
-func (x *ServerStateResponse) GetExternalModelAdd() []*ExternalModelAddRequest {
+func (x *MeasurementProto) GetClosed() bool {
 	if x != nil {
-		return x.ExternalModelAdd
+		return x.Closed
+	}
+	return false
+}
+
+func (x *MeasurementProto) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
+	}
+	return ""
+}
+
+func (x *MeasurementProto) GetModifiedByUserName() string {
+	if x != nil {
+		return x.ModifiedByUserName
+	}
+	return ""
+}
+
+func (x *MeasurementProto) GetSegmentLength() []float32 {
+	if x != nil {
+		return x.SegmentLength
 	}
 	return nil
 }
 
-func (x *ServerStateResponse) GetExternalModelRemove() []*ExternalModelRemoveRequest {
+func (x *MeasurementProto) GetTotalLength() float32 {
 	if x != nil {
-		return x.ExternalModelRemove
+		return x.TotalLength
+	}
+	return 0
+}
+
+func (x *MeasurementProto) GetArea() float32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *MeasurementProto) GetAngleDegrees() []float32 {
+	if x != nil {
+		return x.AngleDegrees
 	}
 	return nil
 }
 
-func (x *ServerStateResponse) GetServerInfo() *ServerInfoProto {
+func (x *MeasurementProto) GetUnitSystem() RoomSettingsProto_UnitSystem {
 	if x != nil {
-		return x.ServerInfo
+		return x.UnitSystem
+	}
+	return RoomSettingsProto_METRIC
+}
+
+func (x *MeasurementProto) GetSegmentLengthLabel() []string {
+	if x != nil {
+		return x.SegmentLengthLabel
 	}
 	return nil
 }
 
-// UpdateDeviceRequest contains a single state update from a connected client
-type UpdateDeviceRequest struct {
+func (x *MeasurementProto) GetTotalLengthLabel() string {
+	if x != nil {
+		return x.TotalLengthLabel
+	}
+	return ""
+}
+
+func (x *MeasurementProto) GetAreaLabel() string {
+	if x != nil {
+		return x.AreaLabel
+	}
+	return ""
+}
+
+// CommentProto represents a single comment in a thread attached to a brush stroke, 3D model, text note, primitive or
+// measurement
+type CommentProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Optional updates to the users state if changed since last update.
-	UserState *UserStateProto `protobuf:"bytes,1,opt,name=user_state,json=userState,proto3" json:"user_state,omitempty"`
-	// Optional updates to the users localized space if changed since last update.
-	SpaceInfo *SpaceInfoProto `protobuf:"bytes,2,opt,name=space_info,json=spaceInfo,proto3" json:"space_info,omitempty"`
-	// Whether the client requests echoing of changes it makes (e.g. to test server round trips)
-	Echo bool `protobuf:"varint,3,opt,name=echo,proto3" json:"echo,omitempty"`
-	// Optional information about a brush stroke information that was added or updated since last update.
-	BrushStrokeAdd *BrushStrokeAddRequest `protobuf:"bytes,4,opt,name=brush_stroke_add,json=brushStrokeAdd,proto3" json:"brush_stroke_add,omitempty"`
-	// Optional brush stroke information for a brush stroke that was removed since last update.
-	BrushStrokeRemove *BrushStrokeRemoveRequest `protobuf:"bytes,5,opt,name=brush_stroke_remove,json=brushStrokeRemove,proto3" json:"brush_stroke_remove,omitempty"`
-	// Optional information about a 3D model that was added or updated since last update.
-	ExternalModelAdd *ExternalModelAddRequest `protobuf:"bytes,7,opt,name=external_model_add,json=externalModelAdd,proto3" json:"external_model_add,omitempty"`
-	// Optional 3D model information for a 3D model that was removed since last update.
-	ExternalModelRemove *ExternalModelRemoveRequest `protobuf:"bytes,8,opt,name=external_model_remove,json=externalModelRemove,proto3" json:"external_model_remove,omitempty"`
-}
-
-func (x *UpdateDeviceRequest) Reset() {
-	*x = UpdateDeviceRequest{}
+	// The identifier of this comment.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifier of the brush stroke, 3D model, text note, primitive or measurement that this comment is attached to.
+	ContentId string `protobuf:"bytes,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// The anchor id that the commented content is attached to.
+	AnchorId string `protobuf:"bytes,3,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The identifier of the comment that this comment replies to, or empty for the first comment in a thread.
+	ParentCommentId string `protobuf:"bytes,4,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+	// The user identifier for the author of this comment. Set by the server.
+	AuthorUserName string `protobuf:"bytes,5,opt,name=author_user_name,json=authorUserName,proto3" json:"author_user_name,omitempty"`
+	// The display name for the author of this comment. Set by the server.
+	AuthorDisplayName string `protobuf:"bytes,6,opt,name=author_display_name,json=authorDisplayName,proto3" json:"author_display_name,omitempty"`
+	// The time this comment was created in milliseconds since the unix epoch. Set by the server.
+	CreateTimeMillis int64 `protobuf:"varint,7,opt,name=create_time_millis,json=createTimeMillis,proto3" json:"create_time_millis,omitempty"`
+	// The time this comment was last modified in milliseconds since the unix epoch. Set by the server.
+	ModifiedTimeMillis int64 `protobuf:"varint,8,opt,name=modified_time_millis,json=modifiedTimeMillis,proto3" json:"modified_time_millis,omitempty"`
+	// The text of this comment.
+	Text string `protobuf:"bytes,9,opt,name=text,proto3" json:"text,omitempty"`
+	// Whether this comment has been resolved.
+	Resolved bool `protobuf:"varint,10,opt,name=resolved,proto3" json:"resolved,omitempty"`
+}
+
+func (x *CommentProto) Reset() {
+	*x = CommentProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[21]
+		mi := &file_leap_brush_api_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateDeviceRequest) String() string {
+func (x *CommentProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeviceRequest) ProtoMessage() {}
+func (*CommentProto) ProtoMessage() {}
 
-func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[21]
+func (x *CommentProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1770,85 +2381,132 @@ func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeviceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CommentProto.ProtoReflect.Descriptor instead.
+func (*CommentProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdateDeviceRequest) GetUserState() *UserStateProto {
+func (x *CommentProto) GetId() string {
 	if x != nil {
-		return x.UserState
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateDeviceRequest) GetSpaceInfo() *SpaceInfoProto {
+func (x *CommentProto) GetContentId() string {
 	if x != nil {
-		return x.SpaceInfo
+		return x.ContentId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateDeviceRequest) GetEcho() bool {
+func (x *CommentProto) GetAnchorId() string {
 	if x != nil {
-		return x.Echo
+		return x.AnchorId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateDeviceRequest) GetBrushStrokeAdd() *BrushStrokeAddRequest {
+func (x *CommentProto) GetParentCommentId() string {
 	if x != nil {
-		return x.BrushStrokeAdd
+		return x.ParentCommentId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateDeviceRequest) GetBrushStrokeRemove() *BrushStrokeRemoveRequest {
+func (x *CommentProto) GetAuthorUserName() string {
 	if x != nil {
-		return x.BrushStrokeRemove
+		return x.AuthorUserName
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateDeviceRequest) GetExternalModelAdd() *ExternalModelAddRequest {
+func (x *CommentProto) GetAuthorDisplayName() string {
 	if x != nil {
-		return x.ExternalModelAdd
+		return x.AuthorDisplayName
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateDeviceRequest) GetExternalModelRemove() *ExternalModelRemoveRequest {
+func (x *CommentProto) GetCreateTimeMillis() int64 {
 	if x != nil {
-		return x.ExternalModelRemove
+		return x.CreateTimeMillis
 	}
-	return nil
+	return 0
 }
 
-// UpdateDeviceResponse contains the response for an UpdateDeviceRequest. Server updates are sent back via the
-// RegisterAndListen api instead.
-type UpdateDeviceResponse struct {
+func (x *CommentProto) GetModifiedTimeMillis() int64 {
+	if x != nil {
+		return x.ModifiedTimeMillis
+	}
+	return 0
+}
+
+func (x *CommentProto) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CommentProto) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+// ContentGroupProto represents a named group of brush strokes, 3D models, text notes, primitives and measurements
+// that are moved, hidden or removed as one unit. Content belongs to at most one group.
+type ContentGroupProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *UpdateDeviceResponse) Reset() {
-	*x = UpdateDeviceResponse{}
+	// The identifier of this group.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The display name of this group.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The anchor id for the spatial anchor that this group and all of its members are attached to.
+	AnchorId string `protobuf:"bytes,3,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The transform of this group relative to the provided spatial anchor. Members are rendered with this transform
+	// applied on top of their own transform. Unset is treated as the identity transform. For brush strokes and 3D models
+	// with a parent, the parent's transforms are applied first and then the transform of the group that the root of the
+	// hierarchy belongs to; members with a parent follow their parent, so their own group's transform is not applied.
+	Transform *TransformProto `protobuf:"bytes,4,opt,name=transform,proto3" json:"transform,omitempty"`
+	// The brush stroke ids that are members of this group.
+	BrushStrokeIds []string `protobuf:"bytes,5,rep,name=brush_stroke_ids,json=brushStrokeIds,proto3" json:"brush_stroke_ids,omitempty"`
+	// The 3D model ids that are members of this group.
+	ExternalModelIds []string `protobuf:"bytes,6,rep,name=external_model_ids,json=externalModelIds,proto3" json:"external_model_ids,omitempty"`
+	// Whether the members of this group are hidden.
+	Hidden bool `protobuf:"varint,7,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// The last user identifier to modify this group.
+	ModifiedByUserName string `protobuf:"bytes,8,opt,name=modified_by_user_name,json=modifiedByUserName,proto3" json:"modified_by_user_name,omitempty"`
+	// The text note ids that are members of this group.
+	TextNoteIds []string `protobuf:"bytes,9,rep,name=text_note_ids,json=textNoteIds,proto3" json:"text_note_ids,omitempty"`
+	// The primitive ids that are members of this group.
+	PrimitiveIds []string `protobuf:"bytes,10,rep,name=primitive_ids,json=primitiveIds,proto3" json:"primitive_ids,omitempty"`
+	// The measurement ids that are members of this group.
+	MeasurementIds []string `protobuf:"bytes,11,rep,name=measurement_ids,json=measurementIds,proto3" json:"measurement_ids,omitempty"`
+}
+
+func (x *ContentGroupProto) Reset() {
+	*x = ContentGroupProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[22]
+		mi := &file_leap_brush_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateDeviceResponse) String() string {
+func (x *ContentGroupProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeviceResponse) ProtoMessage() {}
+func (*ContentGroupProto) ProtoMessage() {}
 
-func (x *UpdateDeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[22]
+func (x *ContentGroupProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1859,96 +2517,126 @@ func (x *UpdateDeviceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeviceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ContentGroupProto.ProtoReflect.Descriptor instead.
+func (*ContentGroupProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{19}
 }
 
-// RpcRequest contains a single generic RPC sent from a client
-type RpcRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ContentGroupProto) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-	// The user identifier for the user sending the request.
-	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	// Optional query for the list of connected users.
-	QueryUsersRequest *QueryUsersRequest `protobuf:"bytes,2,opt,name=query_users_request,json=queryUsersRequest,proto3" json:"query_users_request,omitempty"`
+func (x *ContentGroupProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *RpcRequest) Reset() {
-	*x = RpcRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ContentGroupProto) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
 	}
+	return ""
 }
 
-func (x *RpcRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ContentGroupProto) GetTransform() *TransformProto {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
 }
 
-func (*RpcRequest) ProtoMessage() {}
+func (x *ContentGroupProto) GetBrushStrokeIds() []string {
+	if x != nil {
+		return x.BrushStrokeIds
+	}
+	return nil
+}
 
-func (x *RpcRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ContentGroupProto) GetExternalModelIds() []string {
+	if x != nil {
+		return x.ExternalModelIds
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RpcRequest.ProtoReflect.Descriptor instead.
-func (*RpcRequest) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{23}
+func (x *ContentGroupProto) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
 }
 
-func (x *RpcRequest) GetUserName() string {
+func (x *ContentGroupProto) GetModifiedByUserName() string {
 	if x != nil {
-		return x.UserName
+		return x.ModifiedByUserName
 	}
 	return ""
 }
 
-func (x *RpcRequest) GetQueryUsersRequest() *QueryUsersRequest {
+func (x *ContentGroupProto) GetTextNoteIds() []string {
 	if x != nil {
-		return x.QueryUsersRequest
+		return x.TextNoteIds
 	}
 	return nil
 }
 
-// RpcResponse contains the response for the generic Rpc api
-type RpcResponse struct {
+func (x *ContentGroupProto) GetPrimitiveIds() []string {
+	if x != nil {
+		return x.PrimitiveIds
+	}
+	return nil
+}
+
+func (x *ContentGroupProto) GetMeasurementIds() []string {
+	if x != nil {
+		return x.MeasurementIds
+	}
+	return nil
+}
+
+// LayerProto represents a server-managed layer that brush strokes, 3D models, text notes, primitives and measurements
+// can belong to
+type LayerProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Optional response to the QueryUsersRequest if provided in RpcRequest
-	QueryUsersResponse *QueryUsersResponse `protobuf:"bytes,1,opt,name=query_users_response,json=queryUsersResponse,proto3" json:"query_users_response,omitempty"`
-}
-
-func (x *RpcResponse) Reset() {
-	*x = RpcResponse{}
+	// The identifier of this layer.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The display name of this layer.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether content in this layer is visible by default for all users.
+	Visible bool `protobuf:"varint,3,opt,name=visible,proto3" json:"visible,omitempty"`
+	// Whether content in this layer is locked. Edits to locked content are rejected by the server.
+	Locked bool `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`
+	// The visibility override for the receiving user, if they have set one. Only set in server responses.
+	UserVisible *bool `protobuf:"varint,5,opt,name=user_visible,json=userVisible,proto3,oneof" json:"user_visible,omitempty"`
+	// The last user identifier to modify this layer.
+	ModifiedByUserName string `protobuf:"bytes,6,opt,name=modified_by_user_name,json=modifiedByUserName,proto3" json:"modified_by_user_name,omitempty"`
+}
+
+func (x *LayerProto) Reset() {
+	*x = LayerProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[24]
+		mi := &file_leap_brush_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RpcResponse) String() string {
+func (x *LayerProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RpcResponse) ProtoMessage() {}
+func (*LayerProto) ProtoMessage() {}
 
-func (x *RpcResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[24]
+func (x *LayerProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1959,50 +2647,79 @@ func (x *RpcResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RpcResponse.ProtoReflect.Descriptor instead.
-func (*RpcResponse) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use LayerProto.ProtoReflect.Descriptor instead.
+func (*LayerProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RpcResponse) GetQueryUsersResponse() *QueryUsersResponse {
+func (x *LayerProto) GetId() string {
 	if x != nil {
-		return x.QueryUsersResponse
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type QueryUsersResponse_Result struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// User identifier
-	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	// The space information for this user.
-	SpaceInfo *SpaceInfoProto `protobuf:"bytes,2,opt,name=space_info,json=spaceInfo,proto3" json:"space_info,omitempty"`
-	// The display name for this user.
-	UserDisplayName string `protobuf:"bytes,3,opt,name=user_display_name,json=userDisplayName,proto3" json:"user_display_name,omitempty"`
-	// The device type for this user.
-	DeviceType *UserStateProto_DeviceType `protobuf:"varint,4,opt,name=device_type,json=deviceType,proto3,enum=leapbrush.UserStateProto_DeviceType,oneof" json:"device_type,omitempty"`
+func (x *LayerProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *QueryUsersResponse_Result) Reset() {
-	*x = QueryUsersResponse_Result{}
+func (x *LayerProto) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *LayerProto) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *LayerProto) GetUserVisible() bool {
+	if x != nil && x.UserVisible != nil {
+		return *x.UserVisible
+	}
+	return false
+}
+
+func (x *LayerProto) GetModifiedByUserName() string {
+	if x != nil {
+		return x.ModifiedByUserName
+	}
+	return ""
+}
+
+// LayerListProto contains the full list of layers on the server
+type LayerListProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layers []*LayerProto `protobuf:"bytes,1,rep,name=layers,proto3" json:"layers,omitempty"`
+}
+
+func (x *LayerListProto) Reset() {
+	*x = LayerListProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_leap_brush_api_proto_msgTypes[25]
+		mi := &file_leap_brush_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryUsersResponse_Result) String() string {
+func (x *LayerListProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryUsersResponse_Result) ProtoMessage() {}
+func (*LayerListProto) ProtoMessage() {}
 
-func (x *QueryUsersResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_leap_brush_api_proto_msgTypes[25]
+func (x *LayerListProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2013,491 +2730,7726 @@ func (x *QueryUsersResponse_Result) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryUsersResponse_Result.ProtoReflect.Descriptor instead.
-func (*QueryUsersResponse_Result) Descriptor() ([]byte, []int) {
-	return file_leap_brush_api_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use LayerListProto.ProtoReflect.Descriptor instead.
+func (*LayerListProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryUsersResponse_Result) GetUserName() string {
+func (x *LayerListProto) GetLayers() []*LayerProto {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+// RegisterDeviceRequest contains the initialization data for a device registering for updates with the server
+type RegisterDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The version string for the client
+	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+}
+
+func (x *RegisterDeviceRequest) Reset() {
+	*x = RegisterDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RegisterDeviceRequest) GetUserName() string {
 	if x != nil {
 		return x.UserName
 	}
 	return ""
 }
 
-func (x *QueryUsersResponse_Result) GetSpaceInfo() *SpaceInfoProto {
+func (x *RegisterDeviceRequest) GetAppVersion() string {
 	if x != nil {
-		return x.SpaceInfo
+		return x.AppVersion
+	}
+	return ""
+}
+
+// BrushStrokeAddRequest represents a single brush stroke to be added or modified
+type BrushStrokeAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BrushStroke *BrushStrokeProto `protobuf:"bytes,1,opt,name=brush_stroke,json=brushStroke,proto3" json:"brush_stroke,omitempty"`
+}
+
+func (x *BrushStrokeAddRequest) Reset() {
+	*x = BrushStrokeAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrushStrokeAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrushStrokeAddRequest) ProtoMessage() {}
+
+func (x *BrushStrokeAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrushStrokeAddRequest.ProtoReflect.Descriptor instead.
+func (*BrushStrokeAddRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BrushStrokeAddRequest) GetBrushStroke() *BrushStrokeProto {
+	if x != nil {
+		return x.BrushStroke
 	}
 	return nil
 }
 
-func (x *QueryUsersResponse_Result) GetUserDisplayName() string {
+// BrushStrokeRemoveRequest identifies a single brush stroke to be removed
+type BrushStrokeRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// Whether to keep brush strokes and 3D models attached to this brush stroke. If set, they are reparented to this
+	// brush stroke's parent with their transforms composed so that they stay in place, otherwise they are removed too.
+	KeepChildren bool `protobuf:"varint,3,opt,name=keep_children,json=keepChildren,proto3" json:"keep_children,omitempty"`
+}
+
+func (x *BrushStrokeRemoveRequest) Reset() {
+	*x = BrushStrokeRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrushStrokeRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrushStrokeRemoveRequest) ProtoMessage() {}
+
+func (x *BrushStrokeRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrushStrokeRemoveRequest.ProtoReflect.Descriptor instead.
+func (*BrushStrokeRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BrushStrokeRemoveRequest) GetId() string {
 	if x != nil {
-		return x.UserDisplayName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *QueryUsersResponse_Result) GetDeviceType() UserStateProto_DeviceType {
-	if x != nil && x.DeviceType != nil {
-		return *x.DeviceType
+func (x *BrushStrokeRemoveRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
 	}
-	return UserStateProto_DESKTOP_SPECTATOR
+	return ""
 }
 
-var File_leap_brush_api_proto protoreflect.FileDescriptor
+func (x *BrushStrokeRemoveRequest) GetKeepChildren() bool {
+	if x != nil {
+		return x.KeepChildren
+	}
+	return false
+}
 
-var file_leap_brush_api_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x6c, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
-	0x68, 0x22, 0x38, 0x0a, 0x0c, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12,
-	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a,
-	0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x7a, 0x22, 0x49, 0x0a, 0x0f, 0x51,
-	0x75, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
-	0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x7a, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x01, 0x77, 0x22, 0x78, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
-	0x68, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x61,
-	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xac, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
-	0x68, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x61,
-	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2d, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22,
-	0xc4, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x40, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x65,
-	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x56,
-	0x0a, 0x0c, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43,
-	0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x49, 0x53,
-	0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f,
-	0x54, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04,
-	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x04, 0x22, 0xc5, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x28, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x6f,
-	0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0b, 0x74, 0x6f, 0x6f, 0x6c, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5a, 0x12, 0x36, 0x0a,
-	0x0a, 0x72, 0x61, 0x79, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x72, 0x61, 0x79, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa4,
-	0x01, 0x0a, 0x0e, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x31, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68,
-	0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x74, 0x6f, 0x6f, 0x6c,
-	0x50, 0x6f, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x72, 0x61, 0x79, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
-	0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x52, 0x09, 0x72, 0x61, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf8, 0x06, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x49, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
-	0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x68, 0x65, 0x61,
-	0x64, 0x50, 0x6f, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x68,
-	0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x48, 0x61, 0x6e, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x65,
-	0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48,
-	0x0a, 0x10, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
-	0x72, 0x75, 0x73, 0x68, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x48, 0x02, 0x52, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x48, 0x61, 0x6e, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c,
-	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e,
-	0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x72, 0x67, 0x62, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52,
-	0x67, 0x62, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75,
-	0x73, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a,
-	0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0f, 0x68, 0x65,
-	0x61, 0x64, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
-	0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x48, 0x04, 0x52, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x73, 0x65, 0x74, 0x42, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x22, 0x50, 0x0a, 0x09, 0x54, 0x6f, 0x6f, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x45, 0x4e, 0x55, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x4c, 0x41, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x52, 0x41,
-	0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x52, 0x55, 0x53, 0x48, 0x5f, 0x53,
-	0x43, 0x52, 0x49, 0x42, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x52, 0x55,
-	0x53, 0x48, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x10, 0x04, 0x22, 0x33, 0x0a, 0x0a, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x53, 0x4b, 0x54,
-	0x4f, 0x50, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x4d, 0x41, 0x47, 0x49, 0x43, 0x5f, 0x4c, 0x45, 0x41, 0x50, 0x10, 0x01, 0x42, 0x13,
-	0x0a, 0x11, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x68, 0x61, 0x6e,
-	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x69, 0x67, 0x68,
-	0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x68, 0x65, 0x61, 0x64, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79,
-	0x22, 0x47, 0x0a, 0x0b, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x28, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x22, 0xec, 0x02, 0x0a, 0x0e, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2e, 0x0a, 0x06,
-	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c,
-	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x13,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70,
-	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
-	0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0c,
-	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x2a, 0x0a, 0x0b,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4f,
-	0x4e, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x52,
-	0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x10, 0x01, 0x22, 0x88, 0x03, 0x0a, 0x10, 0x42, 0x72, 0x75,
-	0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e,
-	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
-	0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x70, 0x6f, 0x73, 0x65,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
-	0x73, 0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x62, 0x72,
-	0x75, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x6f,
-	0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x72, 0x67, 0x62, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52,
-	0x67, 0x62, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
-	0x5f, 0x72, 0x67, 0x62, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x69, 0x6c,
-	0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x67, 0x62, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x69,
-	0x6c, 0x6c, 0x5f, 0x64, 0x69, 0x6d, 0x6d, 0x65, 0x72, 0x5f, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x6c, 0x44, 0x69, 0x6d, 0x6d, 0x65, 0x72, 0x41, 0x22, 0x23,
-	0x0a, 0x09, 0x42, 0x72, 0x75, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x53,
-	0x43, 0x52, 0x49, 0x42, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x4c,
-	0x59, 0x10, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
-	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68,
-	0x6f, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
-	0x75, 0x73, 0x68, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x31, 0x0a,
-	0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x6f,
-	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x55, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x15, 0x42, 0x72, 0x75, 0x73, 0x68,
-	0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
-	0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65,
-	0x22, 0x47, 0x0a, 0x18, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x17, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x49, 0x0a, 0x1a, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68,
-	0x6f, 0x72, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbe, 0x02, 0x0a, 0x12, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x1a, 0xe7, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c,
-	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75,
-	0x73, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a,
-	0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x60, 0x0a, 0x0f, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x0a,
-	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d,
-	0x69, 0x6e, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xda, 0x03, 0x0a,
-	0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
-	0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4a,
-	0x0a, 0x10, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x61,
-	0x64, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
-	0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65,
-	0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x62, 0x72, 0x75, 0x73,
-	0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x12, 0x53, 0x0a, 0x13, 0x62, 0x72,
-	0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
-	0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x62, 0x72,
-	0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12,
-	0x50, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x65,
-	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x64,
-	0x64, 0x12, 0x59, 0x0a, 0x15, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xeb, 0x03, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x38, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
-	0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x70, 0x61, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x63, 0x68, 0x6f, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x65, 0x63, 0x68, 0x6f, 0x12, 0x4a, 0x0a, 0x10, 0x62, 0x72, 0x75,
-	0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
-	0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f,
-	0x6b, 0x65, 0x41, 0x64, 0x64, 0x12, 0x53, 0x0a, 0x13, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73,
-	0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42,
-	0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74,
-	0x72, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x50, 0x0a, 0x12, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x61, 0x64, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
-	0x73, 0x68, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x12, 0x59, 0x0a, 0x15,
-	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x72,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x65,
-	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x77, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
-	0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x71, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x0b, 0x52, 0x70, 0x63, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
-	0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x12, 0x71, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfc, 0x01, 0x0a, 0x0c, 0x4c, 0x65, 0x61,
-	0x70, 0x42, 0x72, 0x75, 0x73, 0x68, 0x41, 0x70, 0x69, 0x12, 0x59, 0x0a, 0x11, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x20,
-	0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x6c, 0x65, 0x61,
-	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x65, 0x61,
-	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12,
-	0x36, 0x0a, 0x03, 0x52, 0x70, 0x63, 0x12, 0x15, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
-	0x73, 0x68, 0x2e, 0x52, 0x70, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x48, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x6c, 0x61,
-	0x62, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x6c, 0x65, 0x61, 0x70, 0x2e, 0x69, 0x6f, 0x2f, 0x67,
-	0x68, 0x61, 0x7a, 0x65, 0x6e, 0x2f, 0x6c, 0x65, 0x61, 0x70, 0x2d, 0x62, 0x72, 0x75, 0x73, 0x68,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0xaa, 0x02, 0x13, 0x4d, 0x61,
-	0x67, 0x69, 0x63, 0x4c, 0x65, 0x61, 0x70, 0x2e, 0x4c, 0x65, 0x61, 0x70, 0x42, 0x72, 0x75, 0x73,
-	0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// BrushStrokeTransformRequest represents a new transform for a single existing brush stroke
+type BrushStrokeTransformRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The new transform for the brush stroke, relative to the parent if set or otherwise to the provided spatial
+	// anchor.
+	Transform *TransformProto `protobuf:"bytes,3,opt,name=transform,proto3" json:"transform,omitempty"`
+	// The id of the brush stroke or 3D model that the new transform is relative to, or empty for the spatial anchor.
+	// Reparents the brush stroke if different from its current parent. Transforms without a parent_id keep the existing
+	// parent and are relative to it.
+	ParentId *string `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
 }
 
-var (
-	file_leap_brush_api_proto_rawDescOnce sync.Once
-	file_leap_brush_api_proto_rawDescData = file_leap_brush_api_proto_rawDesc
-)
+func (x *BrushStrokeTransformRequest) Reset() {
+	*x = BrushStrokeTransformRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_leap_brush_api_proto_rawDescGZIP() []byte {
-	file_leap_brush_api_proto_rawDescOnce.Do(func() {
-		file_leap_brush_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_leap_brush_api_proto_rawDescData)
-	})
-	return file_leap_brush_api_proto_rawDescData
+func (x *BrushStrokeTransformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_leap_brush_api_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_leap_brush_api_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
-var file_leap_brush_api_proto_goTypes = []interface{}{
-	(BatteryStatusProto_BatteryState)(0), // 0: leapbrush.BatteryStatusProto.BatteryState
-	(UserStateProto_ToolState)(0),        // 1: leapbrush.UserStateProto.ToolState
-	(UserStateProto_DeviceType)(0),       // 2: leapbrush.UserStateProto.DeviceType
-	(SpaceInfoProto_MappingMode)(0),      // 3: leapbrush.SpaceInfoProto.MappingMode
-	(BrushStrokeProto_BrushType)(0),      // 4: leapbrush.BrushStrokeProto.BrushType
-	(*Vector3Proto)(nil),                 // 5: leapbrush.Vector3Proto
-	(*QuaternionProto)(nil),              // 6: leapbrush.QuaternionProto
-	(*PoseProto)(nil),                    // 7: leapbrush.PoseProto
-	(*TransformProto)(nil),               // 8: leapbrush.TransformProto
-	(*BatteryStatusProto)(nil),           // 9: leapbrush.BatteryStatusProto
-	(*ControllerStateProto)(nil),         // 10: leapbrush.ControllerStateProto
-	(*HandStateProto)(nil),               // 11: leapbrush.HandStateProto
-	(*UserStateProto)(nil),               // 12: leapbrush.UserStateProto
-	(*AnchorProto)(nil),                  // 13: leapbrush.AnchorProto
-	(*SpaceInfoProto)(nil),               // 14: leapbrush.SpaceInfoProto
-	(*BrushStrokeProto)(nil),             // 15: leapbrush.BrushStrokeProto
-	(*ExternalModelProto)(nil),           // 16: leapbrush.ExternalModelProto
-	(*RegisterDeviceRequest)(nil),        // 17: leapbrush.RegisterDeviceRequest
-	(*BrushStrokeAddRequest)(nil),        // 18: leapbrush.BrushStrokeAddRequest
-	(*BrushStrokeRemoveRequest)(nil),     // 19: leapbrush.BrushStrokeRemoveRequest
-	(*ExternalModelAddRequest)(nil),      // 20: leapbrush.ExternalModelAddRequest
-	(*ExternalModelRemoveRequest)(nil),   // 21: leapbrush.ExternalModelRemoveRequest
-	(*QueryUsersRequest)(nil),            // 22: leapbrush.QueryUsersRequest
-	(*QueryUsersResponse)(nil),           // 23: leapbrush.QueryUsersResponse
-	(*ServerInfoProto)(nil),              // 24: leapbrush.ServerInfoProto
-	(*ServerStateResponse)(nil),          // 25: leapbrush.ServerStateResponse
-	(*UpdateDeviceRequest)(nil),          // 26: leapbrush.UpdateDeviceRequest
-	(*UpdateDeviceResponse)(nil),         // 27: leapbrush.UpdateDeviceResponse
-	(*RpcRequest)(nil),                   // 28: leapbrush.RpcRequest
-	(*RpcResponse)(nil),                  // 29: leapbrush.RpcResponse
-	(*QueryUsersResponse_Result)(nil),    // 30: leapbrush.QueryUsersResponse.Result
+func (*BrushStrokeTransformRequest) ProtoMessage() {}
+
+func (x *BrushStrokeTransformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_leap_brush_api_proto_depIdxs = []int32{
-	5,  // 0: leapbrush.PoseProto.position:type_name -> leapbrush.Vector3Proto
-	6,  // 1: leapbrush.PoseProto.rotation:type_name -> leapbrush.QuaternionProto
-	5,  // 2: leapbrush.TransformProto.position:type_name -> leapbrush.Vector3Proto
-	6,  // 3: leapbrush.TransformProto.rotation:type_name -> leapbrush.QuaternionProto
-	5,  // 4: leapbrush.TransformProto.scale:type_name -> leapbrush.Vector3Proto
-	0,  // 5: leapbrush.BatteryStatusProto.state:type_name -> leapbrush.BatteryStatusProto.BatteryState
-	7,  // 6: leapbrush.ControllerStateProto.pose:type_name -> leapbrush.PoseProto
-	5,  // 7: leapbrush.ControllerStateProto.ray_points:type_name -> leapbrush.Vector3Proto
-	7,  // 8: leapbrush.HandStateProto.tool_pose:type_name -> leapbrush.PoseProto
-	5,  // 9: leapbrush.HandStateProto.ray_points:type_name -> leapbrush.Vector3Proto
-	7,  // 10: leapbrush.UserStateProto.head_pose:type_name -> leapbrush.PoseProto
-	10, // 11: leapbrush.UserStateProto.controller_state:type_name -> leapbrush.ControllerStateProto
-	11, // 12: leapbrush.UserStateProto.left_hand_state:type_name -> leapbrush.HandStateProto
-	11, // 13: leapbrush.UserStateProto.right_hand_state:type_name -> leapbrush.HandStateProto
-	1,  // 14: leapbrush.UserStateProto.tool_state:type_name -> leapbrush.UserStateProto.ToolState
-	2,  // 15: leapbrush.UserStateProto.device_type:type_name -> leapbrush.UserStateProto.DeviceType
-	9,  // 16: leapbrush.UserStateProto.headset_battery:type_name -> leapbrush.BatteryStatusProto
-	7,  // 17: leapbrush.AnchorProto.pose:type_name -> leapbrush.PoseProto
-	13, // 18: leapbrush.SpaceInfoProto.anchor:type_name -> leapbrush.AnchorProto
-	7,  // 19: leapbrush.SpaceInfoProto.target_space_origin:type_name -> leapbrush.PoseProto
-	3,  // 20: leapbrush.SpaceInfoProto.mapping_mode:type_name -> leapbrush.SpaceInfoProto.MappingMode
-	4,  // 21: leapbrush.BrushStrokeProto.type:type_name -> leapbrush.BrushStrokeProto.BrushType
-	7,  // 22: leapbrush.BrushStrokeProto.brush_pose:type_name -> leapbrush.PoseProto
-	8,  // 23: leapbrush.ExternalModelProto.transform:type_name -> leapbrush.TransformProto
-	15, // 24: leapbrush.BrushStrokeAddRequest.brush_stroke:type_name -> leapbrush.BrushStrokeProto
-	16, // 25: leapbrush.ExternalModelAddRequest.model:type_name -> leapbrush.ExternalModelProto
-	30, // 26: leapbrush.QueryUsersResponse.results:type_name -> leapbrush.QueryUsersResponse.Result
-	12, // 27: leapbrush.ServerStateResponse.user_state:type_name -> leapbrush.UserStateProto
-	18, // 28: leapbrush.ServerStateResponse.brush_stroke_add:type_name -> leapbrush.BrushStrokeAddRequest
-	19, // 29: leapbrush.ServerStateResponse.brush_stroke_remove:type_name -> leapbrush.BrushStrokeRemoveRequest
-	20, // 30: leapbrush.ServerStateResponse.external_model_add:type_name -> leapbrush.ExternalModelAddRequest
-	21, // 31: leapbrush.ServerStateResponse.external_model_remove:type_name -> leapbrush.ExternalModelRemoveRequest
-	24, // 32: leapbrush.ServerStateResponse.server_info:type_name -> leapbrush.ServerInfoProto
-	12, // 33: leapbrush.UpdateDeviceRequest.user_state:type_name -> leapbrush.UserStateProto
-	14, // 34: leapbrush.UpdateDeviceRequest.space_info:type_name -> leapbrush.SpaceInfoProto
-	18, // 35: leapbrush.UpdateDeviceRequest.brush_stroke_add:type_name -> leapbrush.BrushStrokeAddRequest
-	19, // 36: leapbrush.UpdateDeviceRequest.brush_stroke_remove:type_name -> leapbrush.BrushStrokeRemoveRequest
-	20, // 37: leapbrush.UpdateDeviceRequest.external_model_add:type_name -> leapbrush.ExternalModelAddRequest
-	21, // 38: leapbrush.UpdateDeviceRequest.external_model_remove:type_name -> leapbrush.ExternalModelRemoveRequest
-	22, // 39: leapbrush.RpcRequest.query_users_request:type_name -> leapbrush.QueryUsersRequest
-	23, // 40: leapbrush.RpcResponse.query_users_response:type_name -> leapbrush.QueryUsersResponse
-	14, // 41: leapbrush.QueryUsersResponse.Result.space_info:type_name -> leapbrush.SpaceInfoProto
-	2,  // 42: leapbrush.QueryUsersResponse.Result.device_type:type_name -> leapbrush.UserStateProto.DeviceType
-	17, // 43: leapbrush.LeapBrushApi.RegisterAndListen:input_type -> leapbrush.RegisterDeviceRequest
-	26, // 44: leapbrush.LeapBrushApi.UpdateDeviceStream:input_type -> leapbrush.UpdateDeviceRequest
-	28, // 45: leapbrush.LeapBrushApi.Rpc:input_type -> leapbrush.RpcRequest
-	25, // 46: leapbrush.LeapBrushApi.RegisterAndListen:output_type -> leapbrush.ServerStateResponse
-	27, // 47: leapbrush.LeapBrushApi.UpdateDeviceStream:output_type -> leapbrush.UpdateDeviceResponse
-	29, // 48: leapbrush.LeapBrushApi.Rpc:output_type -> leapbrush.RpcResponse
-	46, // [46:49] is the sub-list for method output_type
-	43, // [43:46] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+
+// Deprecated: Use BrushStrokeTransformRequest.ProtoReflect.Descriptor instead.
+func (*BrushStrokeTransformRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{25}
 }
 
-func init() { file_leap_brush_api_proto_init() }
-func file_leap_brush_api_proto_init() {
-	if File_leap_brush_api_proto != nil {
-		return
+func (x *BrushStrokeTransformRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_leap_brush_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Vector3Proto); i {
+	return ""
+}
+
+func (x *BrushStrokeTransformRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *BrushStrokeTransformRequest) GetTransform() *TransformProto {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
+}
+
+func (x *BrushStrokeTransformRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+// ExternalModelAddRequest represents a single 3D model to be added or modified
+type ExternalModelAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model *ExternalModelProto `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+}
+
+func (x *ExternalModelAddRequest) Reset() {
+	*x = ExternalModelAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalModelAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalModelAddRequest) ProtoMessage() {}
+
+func (x *ExternalModelAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalModelAddRequest.ProtoReflect.Descriptor instead.
+func (*ExternalModelAddRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExternalModelAddRequest) GetModel() *ExternalModelProto {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+// ExternalModelRemoveRequest identifies a single 3D model to be removed
+type ExternalModelRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// Whether to keep brush strokes and 3D models attached to this 3D model. If set, they are reparented to this 3D
+	// model's parent with their transforms composed so that they stay in place, otherwise they are removed too.
+	KeepChildren bool `protobuf:"varint,3,opt,name=keep_children,json=keepChildren,proto3" json:"keep_children,omitempty"`
+}
+
+func (x *ExternalModelRemoveRequest) Reset() {
+	*x = ExternalModelRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalModelRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalModelRemoveRequest) ProtoMessage() {}
+
+func (x *ExternalModelRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalModelRemoveRequest.ProtoReflect.Descriptor instead.
+func (*ExternalModelRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExternalModelRemoveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExternalModelRemoveRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *ExternalModelRemoveRequest) GetKeepChildren() bool {
+	if x != nil {
+		return x.KeepChildren
+	}
+	return false
+}
+
+// TextNoteAddRequest represents a single text note to be added or modified
+type TextNoteAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TextNote *TextNoteProto `protobuf:"bytes,1,opt,name=text_note,json=textNote,proto3" json:"text_note,omitempty"`
+}
+
+func (x *TextNoteAddRequest) Reset() {
+	*x = TextNoteAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextNoteAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextNoteAddRequest) ProtoMessage() {}
+
+func (x *TextNoteAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextNoteAddRequest.ProtoReflect.Descriptor instead.
+func (*TextNoteAddRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TextNoteAddRequest) GetTextNote() *TextNoteProto {
+	if x != nil {
+		return x.TextNote
+	}
+	return nil
+}
+
+// TextNoteRemoveRequest identifies a single text note to be removed
+type TextNoteRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+}
+
+func (x *TextNoteRemoveRequest) Reset() {
+	*x = TextNoteRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextNoteRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextNoteRemoveRequest) ProtoMessage() {}
+
+func (x *TextNoteRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextNoteRemoveRequest.ProtoReflect.Descriptor instead.
+func (*TextNoteRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TextNoteRemoveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TextNoteRemoveRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+// PrimitiveAddRequest represents a single primitive to be added or modified
+type PrimitiveAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Primitive *PrimitiveProto `protobuf:"bytes,1,opt,name=primitive,proto3" json:"primitive,omitempty"`
+}
+
+func (x *PrimitiveAddRequest) Reset() {
+	*x = PrimitiveAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrimitiveAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimitiveAddRequest) ProtoMessage() {}
+
+func (x *PrimitiveAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimitiveAddRequest.ProtoReflect.Descriptor instead.
+func (*PrimitiveAddRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PrimitiveAddRequest) GetPrimitive() *PrimitiveProto {
+	if x != nil {
+		return x.Primitive
+	}
+	return nil
+}
+
+// PrimitiveRemoveRequest identifies a single primitive to be removed
+type PrimitiveRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+}
+
+func (x *PrimitiveRemoveRequest) Reset() {
+	*x = PrimitiveRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrimitiveRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimitiveRemoveRequest) ProtoMessage() {}
+
+func (x *PrimitiveRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimitiveRemoveRequest.ProtoReflect.Descriptor instead.
+func (*PrimitiveRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PrimitiveRemoveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PrimitiveRemoveRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+// MeasurementAddRequest represents a single measurement to be added or modified
+type MeasurementAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Measurement *MeasurementProto `protobuf:"bytes,1,opt,name=measurement,proto3" json:"measurement,omitempty"`
+}
+
+func (x *MeasurementAddRequest) Reset() {
+	*x = MeasurementAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeasurementAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasurementAddRequest) ProtoMessage() {}
+
+func (x *MeasurementAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasurementAddRequest.ProtoReflect.Descriptor instead.
+func (*MeasurementAddRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MeasurementAddRequest) GetMeasurement() *MeasurementProto {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+// MeasurementRemoveRequest identifies a single measurement to be removed
+type MeasurementRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+}
+
+func (x *MeasurementRemoveRequest) Reset() {
+	*x = MeasurementRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeasurementRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasurementRemoveRequest) ProtoMessage() {}
+
+func (x *MeasurementRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasurementRemoveRequest.ProtoReflect.Descriptor instead.
+func (*MeasurementRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MeasurementRemoveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MeasurementRemoveRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+// GrabLockProto represents a short-lived exclusive lock held by a user while moving a 3D model or brush stroke. While
+// the lock is held, edits to the content from other users are rejected. The holder keeps the lock by acquiring it
+// again before it expires; locks are also released when the holder disconnects or the content is removed.
+type GrabLockProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the locked 3D model or brush stroke.
+	ContentId string `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AnchorId  string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The user identifier and display name of the user holding the lock, e.g. for showing "being moved by X".
+	UserName        string `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserDisplayName string `protobuf:"bytes,4,opt,name=user_display_name,json=userDisplayName,proto3" json:"user_display_name,omitempty"`
+	// The server time in milliseconds since the unix epoch when the lock expires unless acquired again.
+	ExpireTimeMillis int64 `protobuf:"varint,5,opt,name=expire_time_millis,json=expireTimeMillis,proto3" json:"expire_time_millis,omitempty"`
+}
+
+func (x *GrabLockProto) Reset() {
+	*x = GrabLockProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrabLockProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrabLockProto) ProtoMessage() {}
+
+func (x *GrabLockProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrabLockProto.ProtoReflect.Descriptor instead.
+func (*GrabLockProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GrabLockProto) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *GrabLockProto) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *GrabLockProto) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *GrabLockProto) GetUserDisplayName() string {
+	if x != nil {
+		return x.UserDisplayName
+	}
+	return ""
+}
+
+func (x *GrabLockProto) GetExpireTimeMillis() int64 {
+	if x != nil {
+		return x.ExpireTimeMillis
+	}
+	return 0
+}
+
+// GrabLockRequest identifies a 3D model or brush stroke to acquire or release a grab lock on. When sent by the server,
+// it identifies a lock that was released.
+type GrabLockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentId string `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	AnchorId  string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+}
+
+func (x *GrabLockRequest) Reset() {
+	*x = GrabLockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrabLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrabLockRequest) ProtoMessage() {}
+
+func (x *GrabLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrabLockRequest.ProtoReflect.Descriptor instead.
+func (*GrabLockRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GrabLockRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *GrabLockRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+// CommentAddRequest represents a single comment to be added or modified. Only the author may change the text of a
+// comment, while any user may change whether it is resolved.
+type CommentAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *CommentProto `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *CommentAddRequest) Reset() {
+	*x = CommentAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentAddRequest) ProtoMessage() {}
+
+func (x *CommentAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentAddRequest.ProtoReflect.Descriptor instead.
+func (*CommentAddRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CommentAddRequest) GetComment() *CommentProto {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// CommentRemoveRequest identifies a single comment to be removed. Only the author may remove a comment.
+type CommentRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+}
+
+func (x *CommentRemoveRequest) Reset() {
+	*x = CommentRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentRemoveRequest) ProtoMessage() {}
+
+func (x *CommentRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentRemoveRequest.ProtoReflect.Descriptor instead.
+func (*CommentRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CommentRemoveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CommentRemoveRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+// ContentGroupAddRequest represents a single group to be added or replaced
+type ContentGroupAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *ContentGroupProto `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *ContentGroupAddRequest) Reset() {
+	*x = ContentGroupAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentGroupAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentGroupAddRequest) ProtoMessage() {}
+
+func (x *ContentGroupAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentGroupAddRequest.ProtoReflect.Descriptor instead.
+func (*ContentGroupAddRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ContentGroupAddRequest) GetGroup() *ContentGroupProto {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// ContentGroupUpdateRequest represents a partial update to an existing group
+type ContentGroupUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// Optional new display name for the group.
+	Name *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Optional new transform for the group.
+	Transform *TransformProto `protobuf:"bytes,4,opt,name=transform,proto3" json:"transform,omitempty"`
+	// Optional new hidden state for the group.
+	Hidden *bool `protobuf:"varint,5,opt,name=hidden,proto3,oneof" json:"hidden,omitempty"`
+	// Brush stroke ids to add to the group.
+	AddBrushStrokeIds []string `protobuf:"bytes,6,rep,name=add_brush_stroke_ids,json=addBrushStrokeIds,proto3" json:"add_brush_stroke_ids,omitempty"`
+	// Brush stroke ids to remove from the group.
+	RemoveBrushStrokeIds []string `protobuf:"bytes,7,rep,name=remove_brush_stroke_ids,json=removeBrushStrokeIds,proto3" json:"remove_brush_stroke_ids,omitempty"`
+	// 3D model ids to add to the group.
+	AddExternalModelIds []string `protobuf:"bytes,8,rep,name=add_external_model_ids,json=addExternalModelIds,proto3" json:"add_external_model_ids,omitempty"`
+	// 3D model ids to remove from the group.
+	RemoveExternalModelIds []string `protobuf:"bytes,9,rep,name=remove_external_model_ids,json=removeExternalModelIds,proto3" json:"remove_external_model_ids,omitempty"`
+	// Text note ids to add to the group.
+	AddTextNoteIds []string `protobuf:"bytes,10,rep,name=add_text_note_ids,json=addTextNoteIds,proto3" json:"add_text_note_ids,omitempty"`
+	// Text note ids to remove from the group.
+	RemoveTextNoteIds []string `protobuf:"bytes,11,rep,name=remove_text_note_ids,json=removeTextNoteIds,proto3" json:"remove_text_note_ids,omitempty"`
+	// Primitive ids to add to the group.
+	AddPrimitiveIds []string `protobuf:"bytes,12,rep,name=add_primitive_ids,json=addPrimitiveIds,proto3" json:"add_primitive_ids,omitempty"`
+	// Primitive ids to remove from the group.
+	RemovePrimitiveIds []string `protobuf:"bytes,13,rep,name=remove_primitive_ids,json=removePrimitiveIds,proto3" json:"remove_primitive_ids,omitempty"`
+	// Measurement ids to add to the group.
+	AddMeasurementIds []string `protobuf:"bytes,14,rep,name=add_measurement_ids,json=addMeasurementIds,proto3" json:"add_measurement_ids,omitempty"`
+	// Measurement ids to remove from the group.
+	RemoveMeasurementIds []string `protobuf:"bytes,15,rep,name=remove_measurement_ids,json=removeMeasurementIds,proto3" json:"remove_measurement_ids,omitempty"`
+}
+
+func (x *ContentGroupUpdateRequest) Reset() {
+	*x = ContentGroupUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentGroupUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentGroupUpdateRequest) ProtoMessage() {}
+
+func (x *ContentGroupUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentGroupUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ContentGroupUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ContentGroupUpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContentGroupUpdateRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *ContentGroupUpdateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ContentGroupUpdateRequest) GetTransform() *TransformProto {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetHidden() bool {
+	if x != nil && x.Hidden != nil {
+		return *x.Hidden
+	}
+	return false
+}
+
+func (x *ContentGroupUpdateRequest) GetAddBrushStrokeIds() []string {
+	if x != nil {
+		return x.AddBrushStrokeIds
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetRemoveBrushStrokeIds() []string {
+	if x != nil {
+		return x.RemoveBrushStrokeIds
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetAddExternalModelIds() []string {
+	if x != nil {
+		return x.AddExternalModelIds
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetRemoveExternalModelIds() []string {
+	if x != nil {
+		return x.RemoveExternalModelIds
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetAddTextNoteIds() []string {
+	if x != nil {
+		return x.AddTextNoteIds
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetRemoveTextNoteIds() []string {
+	if x != nil {
+		return x.RemoveTextNoteIds
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetAddPrimitiveIds() []string {
+	if x != nil {
+		return x.AddPrimitiveIds
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetRemovePrimitiveIds() []string {
+	if x != nil {
+		return x.RemovePrimitiveIds
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetAddMeasurementIds() []string {
+	if x != nil {
+		return x.AddMeasurementIds
+	}
+	return nil
+}
+
+func (x *ContentGroupUpdateRequest) GetRemoveMeasurementIds() []string {
+	if x != nil {
+		return x.RemoveMeasurementIds
+	}
+	return nil
+}
+
+// ContentGroupRemoveRequest identifies a single group to be removed
+type ContentGroupRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// Whether the members of the group should be removed along with the group (only valid in UpdateDeviceRequest).
+	RemoveMembers bool `protobuf:"varint,3,opt,name=remove_members,json=removeMembers,proto3" json:"remove_members,omitempty"`
+}
+
+func (x *ContentGroupRemoveRequest) Reset() {
+	*x = ContentGroupRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentGroupRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentGroupRemoveRequest) ProtoMessage() {}
+
+func (x *ContentGroupRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentGroupRemoveRequest.ProtoReflect.Descriptor instead.
+func (*ContentGroupRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ContentGroupRemoveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContentGroupRemoveRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *ContentGroupRemoveRequest) GetRemoveMembers() bool {
+	if x != nil {
+		return x.RemoveMembers
+	}
+	return false
+}
+
+// SetLayerRequest contains request parameters for an rpc to add or modify a layer
+type SetLayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The layer to add or modify. The user_visible field is ignored.
+	Layer *LayerProto `protobuf:"bytes,1,opt,name=layer,proto3" json:"layer,omitempty"`
+	// The server's admin token, required to add a locked layer, lock or unlock a layer, or modify a locked layer.
+	AdminToken string `protobuf:"bytes,2,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`
+}
+
+func (x *SetLayerRequest) Reset() {
+	*x = SetLayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLayerRequest) ProtoMessage() {}
+
+func (x *SetLayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLayerRequest.ProtoReflect.Descriptor instead.
+func (*SetLayerRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetLayerRequest) GetLayer() *LayerProto {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+func (x *SetLayerRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+// RemoveLayerRequest contains request parameters for an rpc to remove a layer. Content in the layer is moved to the
+// default layer.
+type RemoveLayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The server's admin token, required to remove a locked layer.
+	AdminToken string `protobuf:"bytes,2,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`
+}
+
+func (x *RemoveLayerRequest) Reset() {
+	*x = RemoveLayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveLayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLayerRequest) ProtoMessage() {}
+
+func (x *RemoveLayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLayerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLayerRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RemoveLayerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveLayerRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+// SetLayerVisibilityRequest contains request parameters for an rpc to override the visibility of a layer for the
+// requesting user only
+type SetLayerVisibilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LayerId string `protobuf:"bytes,1,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	// The visibility override for the requesting user, or unset to clear the override and use the layer default.
+	Visible *bool `protobuf:"varint,2,opt,name=visible,proto3,oneof" json:"visible,omitempty"`
+}
+
+func (x *SetLayerVisibilityRequest) Reset() {
+	*x = SetLayerVisibilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLayerVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLayerVisibilityRequest) ProtoMessage() {}
+
+func (x *SetLayerVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLayerVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*SetLayerVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetLayerVisibilityRequest) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
+	}
+	return ""
+}
+
+func (x *SetLayerVisibilityRequest) GetVisible() bool {
+	if x != nil && x.Visible != nil {
+		return *x.Visible
+	}
+	return false
+}
+
+// SetContentLayerRequest contains request parameters for an rpc to move brush strokes, 3D models, text notes,
+// primitives and measurements to a layer
+type SetContentLayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The anchor id that the content is attached to.
+	AnchorId string `protobuf:"bytes,1,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The layer id to move the content to, or empty for the default layer.
+	LayerId          string   `protobuf:"bytes,2,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	BrushStrokeIds   []string `protobuf:"bytes,3,rep,name=brush_stroke_ids,json=brushStrokeIds,proto3" json:"brush_stroke_ids,omitempty"`
+	ExternalModelIds []string `protobuf:"bytes,4,rep,name=external_model_ids,json=externalModelIds,proto3" json:"external_model_ids,omitempty"`
+	TextNoteIds      []string `protobuf:"bytes,5,rep,name=text_note_ids,json=textNoteIds,proto3" json:"text_note_ids,omitempty"`
+	PrimitiveIds     []string `protobuf:"bytes,6,rep,name=primitive_ids,json=primitiveIds,proto3" json:"primitive_ids,omitempty"`
+	MeasurementIds   []string `protobuf:"bytes,7,rep,name=measurement_ids,json=measurementIds,proto3" json:"measurement_ids,omitempty"`
+}
+
+func (x *SetContentLayerRequest) Reset() {
+	*x = SetContentLayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContentLayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContentLayerRequest) ProtoMessage() {}
+
+func (x *SetContentLayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContentLayerRequest.ProtoReflect.Descriptor instead.
+func (*SetContentLayerRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetContentLayerRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *SetContentLayerRequest) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
+	}
+	return ""
+}
+
+func (x *SetContentLayerRequest) GetBrushStrokeIds() []string {
+	if x != nil {
+		return x.BrushStrokeIds
+	}
+	return nil
+}
+
+func (x *SetContentLayerRequest) GetExternalModelIds() []string {
+	if x != nil {
+		return x.ExternalModelIds
+	}
+	return nil
+}
+
+func (x *SetContentLayerRequest) GetTextNoteIds() []string {
+	if x != nil {
+		return x.TextNoteIds
+	}
+	return nil
+}
+
+func (x *SetContentLayerRequest) GetPrimitiveIds() []string {
+	if x != nil {
+		return x.PrimitiveIds
+	}
+	return nil
+}
+
+func (x *SetContentLayerRequest) GetMeasurementIds() []string {
+	if x != nil {
+		return x.MeasurementIds
+	}
+	return nil
+}
+
+// QueryUsersRequest contains request parameters for an rpc to list users connected to the server
+type QueryUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryUsersRequest) Reset() {
+	*x = QueryUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUsersRequest) ProtoMessage() {}
+
+func (x *QueryUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUsersRequest.ProtoReflect.Descriptor instead.
+func (*QueryUsersRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{45}
+}
+
+// EraseRegionRequest contains request parameters for an rpc to erase all content inside a region of space. Brush
+// strokes are erased if the region touches any segment between consecutive brush poses.
+type EraseRegionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The anchor id for the spatial anchor that the region is relative to.
+	AnchorId string `protobuf:"bytes,1,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The shape of the region to erase.
+	Shape EraseRegionRequest_Shape `protobuf:"varint,2,opt,name=shape,proto3,enum=leapbrush.EraseRegionRequest_Shape" json:"shape,omitempty"`
+	// The path for the region, relative to the provided spatial anchor. A SPHERE or BOX is placed at each pose in the
+	// path, while a CAPSULE is swept along the segments between consecutive poses.
+	Path []*PoseProto `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	// The radius for SPHERE and CAPSULE regions.
+	Radius float32 `protobuf:"fixed32,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	// The half extents along each axis for BOX regions, oriented by the rotation of each path pose.
+	BoxHalfExtents *Vector3Proto `protobuf:"bytes,5,opt,name=box_half_extents,json=boxHalfExtents,proto3" json:"box_half_extents,omitempty"`
+	// Whether 3D models with an origin inside the region should also be erased.
+	IncludeExternalModels bool `protobuf:"varint,6,opt,name=include_external_models,json=includeExternalModels,proto3" json:"include_external_models,omitempty"`
+	// Whether text notes with an origin inside the region should also be erased.
+	IncludeTextNotes bool `protobuf:"varint,7,opt,name=include_text_notes,json=includeTextNotes,proto3" json:"include_text_notes,omitempty"`
+	// Whether primitives with a control point inside the region should also be erased.
+	IncludePrimitives bool `protobuf:"varint,8,opt,name=include_primitives,json=includePrimitives,proto3" json:"include_primitives,omitempty"`
+	// Whether measurements with a point inside the region should also be erased.
+	IncludeMeasurements bool `protobuf:"varint,9,opt,name=include_measurements,json=includeMeasurements,proto3" json:"include_measurements,omitempty"`
+}
+
+func (x *EraseRegionRequest) Reset() {
+	*x = EraseRegionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraseRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseRegionRequest) ProtoMessage() {}
+
+func (x *EraseRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseRegionRequest.ProtoReflect.Descriptor instead.
+func (*EraseRegionRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EraseRegionRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *EraseRegionRequest) GetShape() EraseRegionRequest_Shape {
+	if x != nil {
+		return x.Shape
+	}
+	return EraseRegionRequest_SPHERE
+}
+
+func (x *EraseRegionRequest) GetPath() []*PoseProto {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *EraseRegionRequest) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *EraseRegionRequest) GetBoxHalfExtents() *Vector3Proto {
+	if x != nil {
+		return x.BoxHalfExtents
+	}
+	return nil
+}
+
+func (x *EraseRegionRequest) GetIncludeExternalModels() bool {
+	if x != nil {
+		return x.IncludeExternalModels
+	}
+	return false
+}
+
+func (x *EraseRegionRequest) GetIncludeTextNotes() bool {
+	if x != nil {
+		return x.IncludeTextNotes
+	}
+	return false
+}
+
+func (x *EraseRegionRequest) GetIncludePrimitives() bool {
+	if x != nil {
+		return x.IncludePrimitives
+	}
+	return false
+}
+
+func (x *EraseRegionRequest) GetIncludeMeasurements() bool {
+	if x != nil {
+		return x.IncludeMeasurements
+	}
+	return false
+}
+
+// EraseRegionResponse contains the content that was erased by an EraseRegionRequest.
+type EraseRegionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of brush stroke ids that were removed.
+	RemovedBrushStrokeIds []string `protobuf:"bytes,1,rep,name=removed_brush_stroke_ids,json=removedBrushStrokeIds,proto3" json:"removed_brush_stroke_ids,omitempty"`
+	// List of 3D model ids that were removed.
+	RemovedExternalModelIds []string `protobuf:"bytes,2,rep,name=removed_external_model_ids,json=removedExternalModelIds,proto3" json:"removed_external_model_ids,omitempty"`
+	// List of text note ids that were removed.
+	RemovedTextNoteIds []string `protobuf:"bytes,3,rep,name=removed_text_note_ids,json=removedTextNoteIds,proto3" json:"removed_text_note_ids,omitempty"`
+	// List of primitive ids that were removed.
+	RemovedPrimitiveIds []string `protobuf:"bytes,4,rep,name=removed_primitive_ids,json=removedPrimitiveIds,proto3" json:"removed_primitive_ids,omitempty"`
+	// List of measurement ids that were removed.
+	RemovedMeasurementIds []string `protobuf:"bytes,5,rep,name=removed_measurement_ids,json=removedMeasurementIds,proto3" json:"removed_measurement_ids,omitempty"`
+}
+
+func (x *EraseRegionResponse) Reset() {
+	*x = EraseRegionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraseRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseRegionResponse) ProtoMessage() {}
+
+func (x *EraseRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseRegionResponse.ProtoReflect.Descriptor instead.
+func (*EraseRegionResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EraseRegionResponse) GetRemovedBrushStrokeIds() []string {
+	if x != nil {
+		return x.RemovedBrushStrokeIds
+	}
+	return nil
+}
+
+func (x *EraseRegionResponse) GetRemovedExternalModelIds() []string {
+	if x != nil {
+		return x.RemovedExternalModelIds
+	}
+	return nil
+}
+
+func (x *EraseRegionResponse) GetRemovedTextNoteIds() []string {
+	if x != nil {
+		return x.RemovedTextNoteIds
+	}
+	return nil
+}
+
+func (x *EraseRegionResponse) GetRemovedPrimitiveIds() []string {
+	if x != nil {
+		return x.RemovedPrimitiveIds
+	}
+	return nil
+}
+
+func (x *EraseRegionResponse) GetRemovedMeasurementIds() []string {
+	if x != nil {
+		return x.RemovedMeasurementIds
+	}
+	return nil
+}
+
+// ClearContentRequest contains request parameters for an rpc to remove all brush strokes, 3D models, text notes,
+// primitives and measurements matching a filter. Every filter that is set must match for content to be removed.
+type ClearContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional user identifier for the creator of the content to remove. For non-admin requests, this must be empty or
+	// match the requesting user, and defaults to the requesting user.
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Optional anchor id that the content to remove is attached to.
+	AnchorId string `protobuf:"bytes,2,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// Optional brush stroke color of the content to remove. Content other than brush strokes never matches this filter.
+	StrokeColorRgb *uint32 `protobuf:"varint,3,opt,name=stroke_color_rgb,json=strokeColorRgb,proto3,oneof" json:"stroke_color_rgb,omitempty"`
+	// Optional minimum age in seconds of the content to remove, measured from when the server first received it.
+	OlderThanSeconds *float32 `protobuf:"fixed32,4,opt,name=older_than_seconds,json=olderThanSeconds,proto3,oneof" json:"older_than_seconds,omitempty"`
+}
+
+func (x *ClearContentRequest) Reset() {
+	*x = ClearContentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearContentRequest) ProtoMessage() {}
+
+func (x *ClearContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearContentRequest.ProtoReflect.Descriptor instead.
+func (*ClearContentRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ClearContentRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ClearContentRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *ClearContentRequest) GetStrokeColorRgb() uint32 {
+	if x != nil && x.StrokeColorRgb != nil {
+		return *x.StrokeColorRgb
+	}
+	return 0
+}
+
+func (x *ClearContentRequest) GetOlderThanSeconds() float32 {
+	if x != nil && x.OlderThanSeconds != nil {
+		return *x.OlderThanSeconds
+	}
+	return 0
+}
+
+// AdminClearContentRequest contains request parameters for an rpc to remove content created by any user.
+type AdminClearContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The admin token configured on the server.
+	AdminToken string `protobuf:"bytes,1,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`
+	// The filter for content to remove.
+	Filter *ClearContentRequest `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *AdminClearContentRequest) Reset() {
+	*x = AdminClearContentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminClearContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminClearContentRequest) ProtoMessage() {}
+
+func (x *AdminClearContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminClearContentRequest.ProtoReflect.Descriptor instead.
+func (*AdminClearContentRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AdminClearContentRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+func (x *AdminClearContentRequest) GetFilter() *ClearContentRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ClearContentResponse contains the content that was removed by a ClearContentRequest or AdminClearContentRequest.
+type ClearContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of brush stroke ids that were removed.
+	RemovedBrushStrokeIds []string `protobuf:"bytes,1,rep,name=removed_brush_stroke_ids,json=removedBrushStrokeIds,proto3" json:"removed_brush_stroke_ids,omitempty"`
+	// List of 3D model ids that were removed.
+	RemovedExternalModelIds []string `protobuf:"bytes,2,rep,name=removed_external_model_ids,json=removedExternalModelIds,proto3" json:"removed_external_model_ids,omitempty"`
+	// List of text note ids that were removed.
+	RemovedTextNoteIds []string `protobuf:"bytes,3,rep,name=removed_text_note_ids,json=removedTextNoteIds,proto3" json:"removed_text_note_ids,omitempty"`
+	// List of primitive ids that were removed.
+	RemovedPrimitiveIds []string `protobuf:"bytes,4,rep,name=removed_primitive_ids,json=removedPrimitiveIds,proto3" json:"removed_primitive_ids,omitempty"`
+	// List of measurement ids that were removed.
+	RemovedMeasurementIds []string `protobuf:"bytes,5,rep,name=removed_measurement_ids,json=removedMeasurementIds,proto3" json:"removed_measurement_ids,omitempty"`
+}
+
+func (x *ClearContentResponse) Reset() {
+	*x = ClearContentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearContentResponse) ProtoMessage() {}
+
+func (x *ClearContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearContentResponse.ProtoReflect.Descriptor instead.
+func (*ClearContentResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ClearContentResponse) GetRemovedBrushStrokeIds() []string {
+	if x != nil {
+		return x.RemovedBrushStrokeIds
+	}
+	return nil
+}
+
+func (x *ClearContentResponse) GetRemovedExternalModelIds() []string {
+	if x != nil {
+		return x.RemovedExternalModelIds
+	}
+	return nil
+}
+
+func (x *ClearContentResponse) GetRemovedTextNoteIds() []string {
+	if x != nil {
+		return x.RemovedTextNoteIds
+	}
+	return nil
+}
+
+func (x *ClearContentResponse) GetRemovedPrimitiveIds() []string {
+	if x != nil {
+		return x.RemovedPrimitiveIds
+	}
+	return nil
+}
+
+func (x *ClearContentResponse) GetRemovedMeasurementIds() []string {
+	if x != nil {
+		return x.RemovedMeasurementIds
+	}
+	return nil
+}
+
+// SetRoomSettingsRequest contains request parameters for an rpc to change the room settings for all users
+type SetRoomSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *RoomSettingsProto `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *SetRoomSettingsRequest) Reset() {
+	*x = SetRoomSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRoomSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomSettingsRequest) ProtoMessage() {}
+
+func (x *SetRoomSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetRoomSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetRoomSettingsRequest) GetSettings() *RoomSettingsProto {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// QueryCommentsRequest contains request parameters for an rpc to list comments
+type QueryCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The anchor id to list comments for.
+	AnchorId string `protobuf:"bytes,1,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// Optional content id to only list comments attached to that content.
+	ContentId string `protobuf:"bytes,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Whether resolved comments should be included.
+	IncludeResolved bool `protobuf:"varint,3,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+}
+
+func (x *QueryCommentsRequest) Reset() {
+	*x = QueryCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCommentsRequest) ProtoMessage() {}
+
+func (x *QueryCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCommentsRequest.ProtoReflect.Descriptor instead.
+func (*QueryCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *QueryCommentsRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *QueryCommentsRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *QueryCommentsRequest) GetIncludeResolved() bool {
+	if x != nil {
+		return x.IncludeResolved
+	}
+	return false
+}
+
+// QueryCommentsResponse contains the results list for a QueryCommentsRequest, ordered by creation time.
+type QueryCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*CommentProto `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *QueryCommentsResponse) Reset() {
+	*x = QueryCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCommentsResponse) ProtoMessage() {}
+
+func (x *QueryCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCommentsResponse.ProtoReflect.Descriptor instead.
+func (*QueryCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *QueryCommentsResponse) GetComments() []*CommentProto {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// QueryUsersResponse contains the results list for currently connected users.
+type QueryUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of users connected to the server.
+	Results []*QueryUsersResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *QueryUsersResponse) Reset() {
+	*x = QueryUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUsersResponse) ProtoMessage() {}
+
+func (x *QueryUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUsersResponse.ProtoReflect.Descriptor instead.
+func (*QueryUsersResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *QueryUsersResponse) GetResults() []*QueryUsersResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ServerInfoProto contians information about the server
+type ServerInfoProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version string for the server
+	ServerVersion string `protobuf:"bytes,1,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	// The minimum version string required for connecting to this server.
+	MinAppVersion string `protobuf:"bytes,2,opt,name=min_app_version,json=minAppVersion,proto3" json:"min_app_version,omitempty"`
+}
+
+func (x *ServerInfoProto) Reset() {
+	*x = ServerInfoProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfoProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoProto) ProtoMessage() {}
+
+func (x *ServerInfoProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoProto.ProtoReflect.Descriptor instead.
+func (*ServerInfoProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ServerInfoProto) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *ServerInfoProto) GetMinAppVersion() string {
+	if x != nil {
+		return x.MinAppVersion
+	}
+	return ""
+}
+
+// BroadcastMessageProto contains a message from the server operator to show to all users.
+type BroadcastMessageProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The message text.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// The server time in milliseconds since the unix epoch when the message was sent.
+	SendTimeMillis int64 `protobuf:"varint,2,opt,name=send_time_millis,json=sendTimeMillis,proto3" json:"send_time_millis,omitempty"`
+}
+
+func (x *BroadcastMessageProto) Reset() {
+	*x = BroadcastMessageProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastMessageProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastMessageProto) ProtoMessage() {}
+
+func (x *BroadcastMessageProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastMessageProto.ProtoReflect.Descriptor instead.
+func (*BroadcastMessageProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BroadcastMessageProto) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *BroadcastMessageProto) GetSendTimeMillis() int64 {
+	if x != nil {
+		return x.SendTimeMillis
+	}
+	return 0
+}
+
+// ShutdownNoticeProto notifies clients that the server is about to shut down. Clients should send any pending updates,
+// close their streams and reconnect.
+type ShutdownNoticeProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The server time in milliseconds since the unix epoch by which the server will have closed all connections.
+	ShutdownTimeMillis int64 `protobuf:"varint,1,opt,name=shutdown_time_millis,json=shutdownTimeMillis,proto3" json:"shutdown_time_millis,omitempty"`
+	// Optional address ("host:port") of a server that clients should reconnect to. If empty, clients should reconnect
+	// to the same address once it is available again.
+	ReconnectAddress string `protobuf:"bytes,2,opt,name=reconnect_address,json=reconnectAddress,proto3" json:"reconnect_address,omitempty"`
+}
+
+func (x *ShutdownNoticeProto) Reset() {
+	*x = ShutdownNoticeProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShutdownNoticeProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownNoticeProto) ProtoMessage() {}
+
+func (x *ShutdownNoticeProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownNoticeProto.ProtoReflect.Descriptor instead.
+func (*ShutdownNoticeProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ShutdownNoticeProto) GetShutdownTimeMillis() int64 {
+	if x != nil {
+		return x.ShutdownTimeMillis
+	}
+	return 0
+}
+
+func (x *ShutdownNoticeProto) GetReconnectAddress() string {
+	if x != nil {
+		return x.ReconnectAddress
+	}
+	return ""
+}
+
+// ServerStateResponse contains a single response from the server containing state updates.
+type ServerStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional list of user states that have changed since last update
+	UserState []*UserStateProto `protobuf:"bytes,1,rep,name=user_state,json=userState,proto3" json:"user_state,omitempty"`
+	// Optional list of brush strokes that have been added or modified since last update.
+	BrushStrokeAdd []*BrushStrokeAddRequest `protobuf:"bytes,2,rep,name=brush_stroke_add,json=brushStrokeAdd,proto3" json:"brush_stroke_add,omitempty"`
+	// Optional list of brush strokes that have been removed since last update.
+	BrushStrokeRemove []*BrushStrokeRemoveRequest `protobuf:"bytes,3,rep,name=brush_stroke_remove,json=brushStrokeRemove,proto3" json:"brush_stroke_remove,omitempty"`
+	// Optional list of 3D models that have been added or modified since last update.
+	ExternalModelAdd []*ExternalModelAddRequest `protobuf:"bytes,4,rep,name=external_model_add,json=externalModelAdd,proto3" json:"external_model_add,omitempty"`
+	// Optional list of 3D models that have been removed since last update.
+	ExternalModelRemove []*ExternalModelRemoveRequest `protobuf:"bytes,5,rep,name=external_model_remove,json=externalModelRemove,proto3" json:"external_model_remove,omitempty"`
+	// Optional information about the server
+	ServerInfo *ServerInfoProto `protobuf:"bytes,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	// Optional list of brush strokes that have been transformed since last update.
+	BrushStrokeTransform []*BrushStrokeTransformRequest `protobuf:"bytes,7,rep,name=brush_stroke_transform,json=brushStrokeTransform,proto3" json:"brush_stroke_transform,omitempty"`
+	// Optional list of groups that have been added or modified since last update.
+	ContentGroupAdd []*ContentGroupAddRequest `protobuf:"bytes,8,rep,name=content_group_add,json=contentGroupAdd,proto3" json:"content_group_add,omitempty"`
+	// Optional list of groups that have been removed since last update.
+	ContentGroupRemove []*ContentGroupRemoveRequest `protobuf:"bytes,9,rep,name=content_group_remove,json=contentGroupRemove,proto3" json:"content_group_remove,omitempty"`
+	// Optional full list of layers if changed since last update, including the receiving user's visibility overrides.
+	LayerList *LayerListProto `protobuf:"bytes,10,opt,name=layer_list,json=layerList,proto3" json:"layer_list,omitempty"`
+	// Optional list of text notes that have been added or modified since last update.
+	TextNoteAdd []*TextNoteAddRequest `protobuf:"bytes,11,rep,name=text_note_add,json=textNoteAdd,proto3" json:"text_note_add,omitempty"`
+	// Optional list of text notes that have been removed since last update.
+	TextNoteRemove []*TextNoteRemoveRequest `protobuf:"bytes,12,rep,name=text_note_remove,json=textNoteRemove,proto3" json:"text_note_remove,omitempty"`
+	// Optional list of comments that have been added or modified since last update.
+	CommentAdd []*CommentAddRequest `protobuf:"bytes,13,rep,name=comment_add,json=commentAdd,proto3" json:"comment_add,omitempty"`
+	// Optional list of comments that have been removed since last update.
+	CommentRemove []*CommentRemoveRequest `protobuf:"bytes,14,rep,name=comment_remove,json=commentRemove,proto3" json:"comment_remove,omitempty"`
+	// Optional list of primitives that have been added or modified since last update.
+	PrimitiveAdd []*PrimitiveAddRequest `protobuf:"bytes,15,rep,name=primitive_add,json=primitiveAdd,proto3" json:"primitive_add,omitempty"`
+	// Optional list of primitives that have been removed since last update.
+	PrimitiveRemove []*PrimitiveRemoveRequest `protobuf:"bytes,16,rep,name=primitive_remove,json=primitiveRemove,proto3" json:"primitive_remove,omitempty"`
+	// Optional room settings if they have changed since last update.
+	RoomSettings *RoomSettingsProto `protobuf:"bytes,17,opt,name=room_settings,json=roomSettings,proto3" json:"room_settings,omitempty"`
+	// Optional list of measurements that have been added or modified since last update.
+	MeasurementAdd []*MeasurementAddRequest `protobuf:"bytes,18,rep,name=measurement_add,json=measurementAdd,proto3" json:"measurement_add,omitempty"`
+	// Optional list of measurements that have been removed since last update.
+	MeasurementRemove []*MeasurementRemoveRequest `protobuf:"bytes,19,rep,name=measurement_remove,json=measurementRemove,proto3" json:"measurement_remove,omitempty"`
+	// The server time in milliseconds since the unix epoch when this response was sent, for synchronizing animations.
+	ServerTimeMillis int64 `protobuf:"varint,20,opt,name=server_time_millis,json=serverTimeMillis,proto3" json:"server_time_millis,omitempty"`
+	// Optional list of grab locks that have been acquired or renewed since last update. A user requesting a lock is
+	// always sent the resulting lock, which names a different user if the request was denied.
+	GrabLockAdd []*GrabLockProto `protobuf:"bytes,21,rep,name=grab_lock_add,json=grabLockAdd,proto3" json:"grab_lock_add,omitempty"`
+	// Optional list of grab locks that have been released or expired since last update.
+	GrabLockRemove []*GrabLockRequest `protobuf:"bytes,22,rep,name=grab_lock_remove,json=grabLockRemove,proto3" json:"grab_lock_remove,omitempty"`
+	// Optional notice that the server is shutting down, sent once to each connected client.
+	ShutdownNotice *ShutdownNoticeProto `protobuf:"bytes,23,opt,name=shutdown_notice,json=shutdownNotice,proto3" json:"shutdown_notice,omitempty"`
+	// Optional list of messages from the server operator to show to the user, sent once to each connected client.
+	BroadcastMessage []*BroadcastMessageProto `protobuf:"bytes,24,rep,name=broadcast_message,json=broadcastMessage,proto3" json:"broadcast_message,omitempty"`
+}
+
+func (x *ServerStateResponse) Reset() {
+	*x = ServerStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStateResponse) ProtoMessage() {}
+
+func (x *ServerStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStateResponse.ProtoReflect.Descriptor instead.
+func (*ServerStateResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ServerStateResponse) GetUserState() []*UserStateProto {
+	if x != nil {
+		return x.UserState
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetBrushStrokeAdd() []*BrushStrokeAddRequest {
+	if x != nil {
+		return x.BrushStrokeAdd
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetBrushStrokeRemove() []*BrushStrokeRemoveRequest {
+	if x != nil {
+		return x.BrushStrokeRemove
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetExternalModelAdd() []*ExternalModelAddRequest {
+	if x != nil {
+		return x.ExternalModelAdd
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetExternalModelRemove() []*ExternalModelRemoveRequest {
+	if x != nil {
+		return x.ExternalModelRemove
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetServerInfo() *ServerInfoProto {
+	if x != nil {
+		return x.ServerInfo
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetBrushStrokeTransform() []*BrushStrokeTransformRequest {
+	if x != nil {
+		return x.BrushStrokeTransform
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetContentGroupAdd() []*ContentGroupAddRequest {
+	if x != nil {
+		return x.ContentGroupAdd
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetContentGroupRemove() []*ContentGroupRemoveRequest {
+	if x != nil {
+		return x.ContentGroupRemove
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetLayerList() *LayerListProto {
+	if x != nil {
+		return x.LayerList
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetTextNoteAdd() []*TextNoteAddRequest {
+	if x != nil {
+		return x.TextNoteAdd
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetTextNoteRemove() []*TextNoteRemoveRequest {
+	if x != nil {
+		return x.TextNoteRemove
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetCommentAdd() []*CommentAddRequest {
+	if x != nil {
+		return x.CommentAdd
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetCommentRemove() []*CommentRemoveRequest {
+	if x != nil {
+		return x.CommentRemove
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetPrimitiveAdd() []*PrimitiveAddRequest {
+	if x != nil {
+		return x.PrimitiveAdd
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetPrimitiveRemove() []*PrimitiveRemoveRequest {
+	if x != nil {
+		return x.PrimitiveRemove
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetRoomSettings() *RoomSettingsProto {
+	if x != nil {
+		return x.RoomSettings
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetMeasurementAdd() []*MeasurementAddRequest {
+	if x != nil {
+		return x.MeasurementAdd
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetMeasurementRemove() []*MeasurementRemoveRequest {
+	if x != nil {
+		return x.MeasurementRemove
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetServerTimeMillis() int64 {
+	if x != nil {
+		return x.ServerTimeMillis
+	}
+	return 0
+}
+
+func (x *ServerStateResponse) GetGrabLockAdd() []*GrabLockProto {
+	if x != nil {
+		return x.GrabLockAdd
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetGrabLockRemove() []*GrabLockRequest {
+	if x != nil {
+		return x.GrabLockRemove
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetShutdownNotice() *ShutdownNoticeProto {
+	if x != nil {
+		return x.ShutdownNotice
+	}
+	return nil
+}
+
+func (x *ServerStateResponse) GetBroadcastMessage() []*BroadcastMessageProto {
+	if x != nil {
+		return x.BroadcastMessage
+	}
+	return nil
+}
+
+// UpdateDeviceRequest contains a single state update from a connected client
+type UpdateDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional updates to the users state if changed since last update.
+	UserState *UserStateProto `protobuf:"bytes,1,opt,name=user_state,json=userState,proto3" json:"user_state,omitempty"`
+	// Optional updates to the users localized space if changed since last update.
+	SpaceInfo *SpaceInfoProto `protobuf:"bytes,2,opt,name=space_info,json=spaceInfo,proto3" json:"space_info,omitempty"`
+	// Whether the client requests echoing of changes it makes (e.g. to test server round trips)
+	Echo bool `protobuf:"varint,3,opt,name=echo,proto3" json:"echo,omitempty"`
+	// Optional information about a brush stroke information that was added or updated since last update.
+	BrushStrokeAdd *BrushStrokeAddRequest `protobuf:"bytes,4,opt,name=brush_stroke_add,json=brushStrokeAdd,proto3" json:"brush_stroke_add,omitempty"`
+	// Optional brush stroke information for a brush stroke that was removed since last update.
+	BrushStrokeRemove *BrushStrokeRemoveRequest `protobuf:"bytes,5,opt,name=brush_stroke_remove,json=brushStrokeRemove,proto3" json:"brush_stroke_remove,omitempty"`
+	// Optional information about a 3D model that was added or updated since last update.
+	ExternalModelAdd *ExternalModelAddRequest `protobuf:"bytes,7,opt,name=external_model_add,json=externalModelAdd,proto3" json:"external_model_add,omitempty"`
+	// Optional 3D model information for a 3D model that was removed since last update.
+	ExternalModelRemove *ExternalModelRemoveRequest `protobuf:"bytes,8,opt,name=external_model_remove,json=externalModelRemove,proto3" json:"external_model_remove,omitempty"`
+	// Optional list of existing brush strokes that were moved, rotated or scaled since last update.
+	BrushStrokeTransform []*BrushStrokeTransformRequest `protobuf:"bytes,9,rep,name=brush_stroke_transform,json=brushStrokeTransform,proto3" json:"brush_stroke_transform,omitempty"`
+	// Optional information about a group that was added or replaced since last update.
+	ContentGroupAdd *ContentGroupAddRequest `protobuf:"bytes,10,opt,name=content_group_add,json=contentGroupAdd,proto3" json:"content_group_add,omitempty"`
+	// Optional partial update to a group (rename, transform, hide or change members) since last update.
+	ContentGroupUpdate *ContentGroupUpdateRequest `protobuf:"bytes,11,opt,name=content_group_update,json=contentGroupUpdate,proto3" json:"content_group_update,omitempty"`
+	// Optional group information for a group that was removed since last update.
+	ContentGroupRemove *ContentGroupRemoveRequest `protobuf:"bytes,12,opt,name=content_group_remove,json=contentGroupRemove,proto3" json:"content_group_remove,omitempty"`
+	// Optional information about a text note that was added or updated since last update.
+	TextNoteAdd *TextNoteAddRequest `protobuf:"bytes,13,opt,name=text_note_add,json=textNoteAdd,proto3" json:"text_note_add,omitempty"`
+	// Optional text note information for a text note that was removed since last update.
+	TextNoteRemove *TextNoteRemoveRequest `protobuf:"bytes,14,opt,name=text_note_remove,json=textNoteRemove,proto3" json:"text_note_remove,omitempty"`
+	// Optional information about a comment that was added or updated since last update.
+	CommentAdd *CommentAddRequest `protobuf:"bytes,15,opt,name=comment_add,json=commentAdd,proto3" json:"comment_add,omitempty"`
+	// Optional comment information for a comment that was removed since last update.
+	CommentRemove *CommentRemoveRequest `protobuf:"bytes,16,opt,name=comment_remove,json=commentRemove,proto3" json:"comment_remove,omitempty"`
+	// Optional information about a primitive that was added or updated since last update.
+	PrimitiveAdd *PrimitiveAddRequest `protobuf:"bytes,17,opt,name=primitive_add,json=primitiveAdd,proto3" json:"primitive_add,omitempty"`
+	// Optional primitive information for a primitive that was removed since last update.
+	PrimitiveRemove *PrimitiveRemoveRequest `protobuf:"bytes,18,opt,name=primitive_remove,json=primitiveRemove,proto3" json:"primitive_remove,omitempty"`
+	// Optional information about a measurement that was added or updated since last update.
+	MeasurementAdd *MeasurementAddRequest `protobuf:"bytes,19,opt,name=measurement_add,json=measurementAdd,proto3" json:"measurement_add,omitempty"`
+	// Optional measurement information for a measurement that was removed since last update.
+	MeasurementRemove *MeasurementRemoveRequest `protobuf:"bytes,20,opt,name=measurement_remove,json=measurementRemove,proto3" json:"measurement_remove,omitempty"`
+	// Optional request to acquire or renew a grab lock on a 3D model or brush stroke being moved.
+	GrabLockAcquire *GrabLockRequest `protobuf:"bytes,21,opt,name=grab_lock_acquire,json=grabLockAcquire,proto3" json:"grab_lock_acquire,omitempty"`
+	// Optional request to release a grab lock once the user stops moving a 3D model or brush stroke.
+	GrabLockRelease *GrabLockRequest `protobuf:"bytes,22,opt,name=grab_lock_release,json=grabLockRelease,proto3" json:"grab_lock_release,omitempty"`
+}
+
+func (x *UpdateDeviceRequest) Reset() {
+	*x = UpdateDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceRequest) ProtoMessage() {}
+
+func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateDeviceRequest) GetUserState() *UserStateProto {
+	if x != nil {
+		return x.UserState
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetSpaceInfo() *SpaceInfoProto {
+	if x != nil {
+		return x.SpaceInfo
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetEcho() bool {
+	if x != nil {
+		return x.Echo
+	}
+	return false
+}
+
+func (x *UpdateDeviceRequest) GetBrushStrokeAdd() *BrushStrokeAddRequest {
+	if x != nil {
+		return x.BrushStrokeAdd
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetBrushStrokeRemove() *BrushStrokeRemoveRequest {
+	if x != nil {
+		return x.BrushStrokeRemove
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetExternalModelAdd() *ExternalModelAddRequest {
+	if x != nil {
+		return x.ExternalModelAdd
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetExternalModelRemove() *ExternalModelRemoveRequest {
+	if x != nil {
+		return x.ExternalModelRemove
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetBrushStrokeTransform() []*BrushStrokeTransformRequest {
+	if x != nil {
+		return x.BrushStrokeTransform
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetContentGroupAdd() *ContentGroupAddRequest {
+	if x != nil {
+		return x.ContentGroupAdd
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetContentGroupUpdate() *ContentGroupUpdateRequest {
+	if x != nil {
+		return x.ContentGroupUpdate
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetContentGroupRemove() *ContentGroupRemoveRequest {
+	if x != nil {
+		return x.ContentGroupRemove
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetTextNoteAdd() *TextNoteAddRequest {
+	if x != nil {
+		return x.TextNoteAdd
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetTextNoteRemove() *TextNoteRemoveRequest {
+	if x != nil {
+		return x.TextNoteRemove
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetCommentAdd() *CommentAddRequest {
+	if x != nil {
+		return x.CommentAdd
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetCommentRemove() *CommentRemoveRequest {
+	if x != nil {
+		return x.CommentRemove
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetPrimitiveAdd() *PrimitiveAddRequest {
+	if x != nil {
+		return x.PrimitiveAdd
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetPrimitiveRemove() *PrimitiveRemoveRequest {
+	if x != nil {
+		return x.PrimitiveRemove
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetMeasurementAdd() *MeasurementAddRequest {
+	if x != nil {
+		return x.MeasurementAdd
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetMeasurementRemove() *MeasurementRemoveRequest {
+	if x != nil {
+		return x.MeasurementRemove
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetGrabLockAcquire() *GrabLockRequest {
+	if x != nil {
+		return x.GrabLockAcquire
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetGrabLockRelease() *GrabLockRequest {
+	if x != nil {
+		return x.GrabLockRelease
+	}
+	return nil
+}
+
+// UpdateDeviceResponse contains the response for an UpdateDeviceRequest. Server updates are sent back via the
+// RegisterAndListen api instead.
+type UpdateDeviceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateDeviceResponse) Reset() {
+	*x = UpdateDeviceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceResponse) ProtoMessage() {}
+
+func (x *UpdateDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{60}
+}
+
+// ModelUploadInfoProto describes a 3D model file being uploaded
+type ModelUploadInfoProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user identifier for the user uploading the file.
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The file name for the model, which must end in .glb or .gltf.
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// The total size of the file in bytes.
+	SizeBytes int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Optional expected content hash of the file. The upload fails if the received file has a different hash.
+	ContentHash string `protobuf:"bytes,4,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+}
+
+func (x *ModelUploadInfoProto) Reset() {
+	*x = ModelUploadInfoProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelUploadInfoProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelUploadInfoProto) ProtoMessage() {}
+
+func (x *ModelUploadInfoProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelUploadInfoProto.ProtoReflect.Descriptor instead.
+func (*ModelUploadInfoProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ModelUploadInfoProto) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ModelUploadInfoProto) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ModelUploadInfoProto) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ModelUploadInfoProto) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+// UploadModelRequest contains a single message in a stream uploading a 3D model file
+type UploadModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Information about the file, required in the first message of the stream.
+	Info *ModelUploadInfoProto `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// The next chunk of file data.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UploadModelRequest) Reset() {
+	*x = UploadModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadModelRequest) ProtoMessage() {}
+
+func (x *UploadModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadModelRequest.ProtoReflect.Descriptor instead.
+func (*UploadModelRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UploadModelRequest) GetInfo() *ModelUploadInfoProto {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *UploadModelRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UploadModelResponse contains the result of a 3D model file upload
+type UploadModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The content hash of the stored file: the lowercase hex encoded SHA-256 of the file data.
+	ContentHash string `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// The size of the stored file in bytes.
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *UploadModelResponse) Reset() {
+	*x = UploadModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadModelResponse) ProtoMessage() {}
+
+func (x *UploadModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadModelResponse.ProtoReflect.Descriptor instead.
+func (*UploadModelResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UploadModelResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *UploadModelResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// ModelCatalogEntryProto describes a 3D model file available in the server's asset store
+type ModelCatalogEntryProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The display name of the model: the title in the glTF asset extras, or the file name without its extension.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The file name of the model.
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// The content hash of the model, for use with the DownloadModel rpc and ExternalModelProto.
+	ContentHash string `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// The size of the model file in bytes.
+	SizeBytes int64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The minimum corner of the axis aligned bounding box of the model's default scene, in meters.
+	BoundsMin *Vector3Proto `protobuf:"bytes,5,opt,name=bounds_min,json=boundsMin,proto3" json:"bounds_min,omitempty"`
+	// The maximum corner of the axis aligned bounding box of the model's default scene, in meters.
+	BoundsMax *Vector3Proto `protobuf:"bytes,6,opt,name=bounds_max,json=boundsMax,proto3" json:"bounds_max,omitempty"`
+	// The number of triangles drawn by the model's default scene.
+	TriangleCount int64 `protobuf:"varint,7,opt,name=triangle_count,json=triangleCount,proto3" json:"triangle_count,omitempty"`
+	// The tags in the glTF asset extras.
+	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Where the model came from.
+	Source ModelCatalogEntryProto_Source `protobuf:"varint,9,opt,name=source,proto3,enum=leapbrush.ModelCatalogEntryProto_Source" json:"source,omitempty"`
+	// The user identifier for the user that uploaded the model, if uploaded.
+	UploadedByUserName string `protobuf:"bytes,10,opt,name=uploaded_by_user_name,json=uploadedByUserName,proto3" json:"uploaded_by_user_name,omitempty"`
+}
+
+func (x *ModelCatalogEntryProto) Reset() {
+	*x = ModelCatalogEntryProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelCatalogEntryProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelCatalogEntryProto) ProtoMessage() {}
+
+func (x *ModelCatalogEntryProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelCatalogEntryProto.ProtoReflect.Descriptor instead.
+func (*ModelCatalogEntryProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ModelCatalogEntryProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModelCatalogEntryProto) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ModelCatalogEntryProto) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *ModelCatalogEntryProto) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ModelCatalogEntryProto) GetBoundsMin() *Vector3Proto {
+	if x != nil {
+		return x.BoundsMin
+	}
+	return nil
+}
+
+func (x *ModelCatalogEntryProto) GetBoundsMax() *Vector3Proto {
+	if x != nil {
+		return x.BoundsMax
+	}
+	return nil
+}
+
+func (x *ModelCatalogEntryProto) GetTriangleCount() int64 {
+	if x != nil {
+		return x.TriangleCount
+	}
+	return 0
+}
+
+func (x *ModelCatalogEntryProto) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ModelCatalogEntryProto) GetSource() ModelCatalogEntryProto_Source {
+	if x != nil {
+		return x.Source
+	}
+	return ModelCatalogEntryProto_UPLOADED
+}
+
+func (x *ModelCatalogEntryProto) GetUploadedByUserName() string {
+	if x != nil {
+		return x.UploadedByUserName
+	}
+	return ""
+}
+
+// QueryModelCatalogRequest contains request parameters for an rpc to list the 3D models available on the server
+type QueryModelCatalogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional tag that listed models must have.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *QueryModelCatalogRequest) Reset() {
+	*x = QueryModelCatalogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryModelCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryModelCatalogRequest) ProtoMessage() {}
+
+func (x *QueryModelCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryModelCatalogRequest.ProtoReflect.Descriptor instead.
+func (*QueryModelCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *QueryModelCatalogRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// QueryModelCatalogResponse contains the 3D models available on the server, sorted by name
+type QueryModelCatalogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Models []*ModelCatalogEntryProto `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+}
+
+func (x *QueryModelCatalogResponse) Reset() {
+	*x = QueryModelCatalogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryModelCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryModelCatalogResponse) ProtoMessage() {}
+
+func (x *QueryModelCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryModelCatalogResponse.ProtoReflect.Descriptor instead.
+func (*QueryModelCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *QueryModelCatalogResponse) GetModels() []*ModelCatalogEntryProto {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+// DownloadModelRequest contains request parameters for downloading a 3D model file
+type DownloadModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user identifier for the user downloading the file.
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The content hash of the file to download.
+	ContentHash string `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+}
+
+func (x *DownloadModelRequest) Reset() {
+	*x = DownloadModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadModelRequest) ProtoMessage() {}
+
+func (x *DownloadModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadModelRequest.ProtoReflect.Descriptor instead.
+func (*DownloadModelRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DownloadModelRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *DownloadModelRequest) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+// DownloadModelResponse contains a single message in a stream downloading a 3D model file
+type DownloadModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The file name that the model was uploaded with. Only set in the first message.
+	FileName string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// The total size of the file in bytes. Only set in the first message.
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The next chunk of file data.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DownloadModelResponse) Reset() {
+	*x = DownloadModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadModelResponse) ProtoMessage() {}
+
+func (x *DownloadModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadModelResponse.ProtoReflect.Descriptor instead.
+func (*DownloadModelResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DownloadModelResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DownloadModelResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DownloadModelResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RpcRequest contains a single generic RPC sent from a client
+type RpcRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user identifier for the user sending the request.
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Optional query for the list of connected users.
+	QueryUsersRequest *QueryUsersRequest `protobuf:"bytes,2,opt,name=query_users_request,json=queryUsersRequest,proto3" json:"query_users_request,omitempty"`
+	// Optional request to erase all content inside a region.
+	EraseRegionRequest *EraseRegionRequest `protobuf:"bytes,3,opt,name=erase_region_request,json=eraseRegionRequest,proto3" json:"erase_region_request,omitempty"`
+	// Optional request to remove content created by the requesting user that matches a filter.
+	ClearContentRequest *ClearContentRequest `protobuf:"bytes,4,opt,name=clear_content_request,json=clearContentRequest,proto3" json:"clear_content_request,omitempty"`
+	// Optional admin request to remove content created by any user that matches a filter.
+	AdminClearContentRequest *AdminClearContentRequest `protobuf:"bytes,5,opt,name=admin_clear_content_request,json=adminClearContentRequest,proto3" json:"admin_clear_content_request,omitempty"`
+	// Optional request to add or modify a layer.
+	SetLayerRequest *SetLayerRequest `protobuf:"bytes,6,opt,name=set_layer_request,json=setLayerRequest,proto3" json:"set_layer_request,omitempty"`
+	// Optional request to remove a layer.
+	RemoveLayerRequest *RemoveLayerRequest `protobuf:"bytes,7,opt,name=remove_layer_request,json=removeLayerRequest,proto3" json:"remove_layer_request,omitempty"`
+	// Optional request to override the visibility of a layer for the requesting user.
+	SetLayerVisibilityRequest *SetLayerVisibilityRequest `protobuf:"bytes,8,opt,name=set_layer_visibility_request,json=setLayerVisibilityRequest,proto3" json:"set_layer_visibility_request,omitempty"`
+	// Optional request to move content to a layer.
+	SetContentLayerRequest *SetContentLayerRequest `protobuf:"bytes,9,opt,name=set_content_layer_request,json=setContentLayerRequest,proto3" json:"set_content_layer_request,omitempty"`
+	// Optional query for comments.
+	QueryCommentsRequest *QueryCommentsRequest `protobuf:"bytes,10,opt,name=query_comments_request,json=queryCommentsRequest,proto3" json:"query_comments_request,omitempty"`
+	// Optional request to change the room settings.
+	SetRoomSettingsRequest *SetRoomSettingsRequest `protobuf:"bytes,11,opt,name=set_room_settings_request,json=setRoomSettingsRequest,proto3" json:"set_room_settings_request,omitempty"`
+	// Optional query for the 3D models available on the server.
+	QueryModelCatalogRequest *QueryModelCatalogRequest `protobuf:"bytes,12,opt,name=query_model_catalog_request,json=queryModelCatalogRequest,proto3" json:"query_model_catalog_request,omitempty"`
+}
+
+func (x *RpcRequest) Reset() {
+	*x = RpcRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcRequest) ProtoMessage() {}
+
+func (x *RpcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcRequest.ProtoReflect.Descriptor instead.
+func (*RpcRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RpcRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *RpcRequest) GetQueryUsersRequest() *QueryUsersRequest {
+	if x != nil {
+		return x.QueryUsersRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetEraseRegionRequest() *EraseRegionRequest {
+	if x != nil {
+		return x.EraseRegionRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetClearContentRequest() *ClearContentRequest {
+	if x != nil {
+		return x.ClearContentRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetAdminClearContentRequest() *AdminClearContentRequest {
+	if x != nil {
+		return x.AdminClearContentRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetSetLayerRequest() *SetLayerRequest {
+	if x != nil {
+		return x.SetLayerRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetRemoveLayerRequest() *RemoveLayerRequest {
+	if x != nil {
+		return x.RemoveLayerRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetSetLayerVisibilityRequest() *SetLayerVisibilityRequest {
+	if x != nil {
+		return x.SetLayerVisibilityRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetSetContentLayerRequest() *SetContentLayerRequest {
+	if x != nil {
+		return x.SetContentLayerRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetQueryCommentsRequest() *QueryCommentsRequest {
+	if x != nil {
+		return x.QueryCommentsRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetSetRoomSettingsRequest() *SetRoomSettingsRequest {
+	if x != nil {
+		return x.SetRoomSettingsRequest
+	}
+	return nil
+}
+
+func (x *RpcRequest) GetQueryModelCatalogRequest() *QueryModelCatalogRequest {
+	if x != nil {
+		return x.QueryModelCatalogRequest
+	}
+	return nil
+}
+
+// RpcResponse contains the response for the generic Rpc api
+type RpcResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional response to the QueryUsersRequest if provided in RpcRequest
+	QueryUsersResponse *QueryUsersResponse `protobuf:"bytes,1,opt,name=query_users_response,json=queryUsersResponse,proto3" json:"query_users_response,omitempty"`
+	// Optional response to the EraseRegionRequest if provided in RpcRequest
+	EraseRegionResponse *EraseRegionResponse `protobuf:"bytes,2,opt,name=erase_region_response,json=eraseRegionResponse,proto3" json:"erase_region_response,omitempty"`
+	// Optional response to the ClearContentRequest or AdminClearContentRequest if provided in RpcRequest
+	ClearContentResponse *ClearContentResponse `protobuf:"bytes,3,opt,name=clear_content_response,json=clearContentResponse,proto3" json:"clear_content_response,omitempty"`
+	// Optional response to the QueryCommentsRequest if provided in RpcRequest
+	QueryCommentsResponse *QueryCommentsResponse `protobuf:"bytes,4,opt,name=query_comments_response,json=queryCommentsResponse,proto3" json:"query_comments_response,omitempty"`
+	// Optional response to the QueryModelCatalogRequest if provided in RpcRequest
+	QueryModelCatalogResponse *QueryModelCatalogResponse `protobuf:"bytes,5,opt,name=query_model_catalog_response,json=queryModelCatalogResponse,proto3" json:"query_model_catalog_response,omitempty"`
+}
+
+func (x *RpcResponse) Reset() {
+	*x = RpcResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcResponse) ProtoMessage() {}
+
+func (x *RpcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcResponse.ProtoReflect.Descriptor instead.
+func (*RpcResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RpcResponse) GetQueryUsersResponse() *QueryUsersResponse {
+	if x != nil {
+		return x.QueryUsersResponse
+	}
+	return nil
+}
+
+func (x *RpcResponse) GetEraseRegionResponse() *EraseRegionResponse {
+	if x != nil {
+		return x.EraseRegionResponse
+	}
+	return nil
+}
+
+func (x *RpcResponse) GetClearContentResponse() *ClearContentResponse {
+	if x != nil {
+		return x.ClearContentResponse
+	}
+	return nil
+}
+
+func (x *RpcResponse) GetQueryCommentsResponse() *QueryCommentsResponse {
+	if x != nil {
+		return x.QueryCommentsResponse
+	}
+	return nil
+}
+
+func (x *RpcResponse) GetQueryModelCatalogResponse() *QueryModelCatalogResponse {
+	if x != nil {
+		return x.QueryModelCatalogResponse
+	}
+	return nil
+}
+
+// ListConnectionsRequest contains request parameters for an admin rpc to list connected users
+type ListConnectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListConnectionsRequest) Reset() {
+	*x = ListConnectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsRequest) ProtoMessage() {}
+
+func (x *ListConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{71}
+}
+
+// ListConnectionsResponse contains the users that have a listening stream or have sent updates and not timed out,
+// ordered by user name, and the users that are banned.
+type ListConnectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Connections []*ListConnectionsResponse_Connection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	BannedUsers []*ListConnectionsResponse_BannedUser `protobuf:"bytes,2,rep,name=banned_users,json=bannedUsers,proto3" json:"banned_users,omitempty"`
+}
+
+func (x *ListConnectionsResponse) Reset() {
+	*x = ListConnectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsResponse) ProtoMessage() {}
+
+func (x *ListConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListConnectionsResponse) GetConnections() []*ListConnectionsResponse_Connection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *ListConnectionsResponse) GetBannedUsers() []*ListConnectionsResponse_BannedUser {
+	if x != nil {
+		return x.BannedUsers
+	}
+	return nil
+}
+
+// KickUserRequest contains request parameters for an admin rpc to disconnect a user
+type KickUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user identifier for the user to disconnect.
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Whether the user should also be prevented from connecting again until unbanned.
+	Ban bool `protobuf:"varint,2,opt,name=ban,proto3" json:"ban,omitempty"`
+	// Optional reason returned to the user's client and listed for banned users.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *KickUserRequest) Reset() {
+	*x = KickUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserRequest) ProtoMessage() {}
+
+func (x *KickUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserRequest.ProtoReflect.Descriptor instead.
+func (*KickUserRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *KickUserRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *KickUserRequest) GetBan() bool {
+	if x != nil {
+		return x.Ban
+	}
+	return false
+}
+
+func (x *KickUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// KickUserResponse contains the response for a KickUserRequest.
+type KickUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the user had a listening stream or had sent updates and not timed out.
+	WasConnected bool `protobuf:"varint,1,opt,name=was_connected,json=wasConnected,proto3" json:"was_connected,omitempty"`
+}
+
+func (x *KickUserResponse) Reset() {
+	*x = KickUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserResponse) ProtoMessage() {}
+
+func (x *KickUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserResponse.ProtoReflect.Descriptor instead.
+func (*KickUserResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *KickUserResponse) GetWasConnected() bool {
+	if x != nil {
+		return x.WasConnected
+	}
+	return false
+}
+
+// UnbanUserRequest contains request parameters for an admin rpc to allow a banned user to connect again
+type UnbanUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user identifier for the user to unban.
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+}
+
+func (x *UnbanUserRequest) Reset() {
+	*x = UnbanUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserRequest) ProtoMessage() {}
+
+func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserRequest.ProtoReflect.Descriptor instead.
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UnbanUserRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+// UnbanUserResponse contains the response for an UnbanUserRequest.
+type UnbanUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the user was banned.
+	WasBanned bool `protobuf:"varint,1,opt,name=was_banned,json=wasBanned,proto3" json:"was_banned,omitempty"`
+}
+
+func (x *UnbanUserResponse) Reset() {
+	*x = UnbanUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserResponse) ProtoMessage() {}
+
+func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserResponse.ProtoReflect.Descriptor instead.
+func (*UnbanUserResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UnbanUserResponse) GetWasBanned() bool {
+	if x != nil {
+		return x.WasBanned
+	}
+	return false
+}
+
+// ListAnchorsRequest contains request parameters for an admin rpc to list anchors
+type ListAnchorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAnchorsRequest) Reset() {
+	*x = ListAnchorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnchorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnchorsRequest) ProtoMessage() {}
+
+func (x *ListAnchorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnchorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnchorsRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{77}
+}
+
+// ListAnchorsResponse contains a summary of every anchor known to the server, ordered by anchor id.
+type ListAnchorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Anchors []*ListAnchorsResponse_Anchor `protobuf:"bytes,1,rep,name=anchors,proto3" json:"anchors,omitempty"`
+}
+
+func (x *ListAnchorsResponse) Reset() {
+	*x = ListAnchorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnchorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnchorsResponse) ProtoMessage() {}
+
+func (x *ListAnchorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnchorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnchorsResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListAnchorsResponse) GetAnchors() []*ListAnchorsResponse_Anchor {
+	if x != nil {
+		return x.Anchors
+	}
+	return nil
+}
+
+// DumpAnchorRequest contains request parameters for an admin rpc to dump the content attached to an anchor
+type DumpAnchorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The spatial anchor identifier.
+	AnchorId string `protobuf:"bytes,1,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+}
+
+func (x *DumpAnchorRequest) Reset() {
+	*x = DumpAnchorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpAnchorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpAnchorRequest) ProtoMessage() {}
+
+func (x *DumpAnchorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpAnchorRequest.ProtoReflect.Descriptor instead.
+func (*DumpAnchorRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DumpAnchorRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+// AnchorContentProto contains all content attached to an anchor. Brush strokes, 3D models, text notes, primitives,
+// measurements and comments are ordered by the time the server first received them, and groups and grab locks by id.
+type AnchorContentProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The spatial anchor identifier.
+	AnchorId       string                `protobuf:"bytes,1,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	BrushStrokes   []*BrushStrokeProto   `protobuf:"bytes,2,rep,name=brush_strokes,json=brushStrokes,proto3" json:"brush_strokes,omitempty"`
+	ExternalModels []*ExternalModelProto `protobuf:"bytes,3,rep,name=external_models,json=externalModels,proto3" json:"external_models,omitempty"`
+	TextNotes      []*TextNoteProto      `protobuf:"bytes,4,rep,name=text_notes,json=textNotes,proto3" json:"text_notes,omitempty"`
+	Primitives     []*PrimitiveProto     `protobuf:"bytes,5,rep,name=primitives,proto3" json:"primitives,omitempty"`
+	Measurements   []*MeasurementProto   `protobuf:"bytes,6,rep,name=measurements,proto3" json:"measurements,omitempty"`
+	ContentGroups  []*ContentGroupProto  `protobuf:"bytes,7,rep,name=content_groups,json=contentGroups,proto3" json:"content_groups,omitempty"`
+	Comments       []*CommentProto       `protobuf:"bytes,8,rep,name=comments,proto3" json:"comments,omitempty"`
+	GrabLocks      []*GrabLockProto      `protobuf:"bytes,9,rep,name=grab_locks,json=grabLocks,proto3" json:"grab_locks,omitempty"`
+}
+
+func (x *AnchorContentProto) Reset() {
+	*x = AnchorContentProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnchorContentProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorContentProto) ProtoMessage() {}
+
+func (x *AnchorContentProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorContentProto.ProtoReflect.Descriptor instead.
+func (*AnchorContentProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AnchorContentProto) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *AnchorContentProto) GetBrushStrokes() []*BrushStrokeProto {
+	if x != nil {
+		return x.BrushStrokes
+	}
+	return nil
+}
+
+func (x *AnchorContentProto) GetExternalModels() []*ExternalModelProto {
+	if x != nil {
+		return x.ExternalModels
+	}
+	return nil
+}
+
+func (x *AnchorContentProto) GetTextNotes() []*TextNoteProto {
+	if x != nil {
+		return x.TextNotes
+	}
+	return nil
+}
+
+func (x *AnchorContentProto) GetPrimitives() []*PrimitiveProto {
+	if x != nil {
+		return x.Primitives
+	}
+	return nil
+}
+
+func (x *AnchorContentProto) GetMeasurements() []*MeasurementProto {
+	if x != nil {
+		return x.Measurements
+	}
+	return nil
+}
+
+func (x *AnchorContentProto) GetContentGroups() []*ContentGroupProto {
+	if x != nil {
+		return x.ContentGroups
+	}
+	return nil
+}
+
+func (x *AnchorContentProto) GetComments() []*CommentProto {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *AnchorContentProto) GetGrabLocks() []*GrabLockProto {
+	if x != nil {
+		return x.GrabLocks
+	}
+	return nil
+}
+
+// ClearAnchorsRequest contains request parameters for an admin rpc to remove all content attached to anchors
+type ClearAnchorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ids of the anchors to clear.
+	AnchorIds []string `protobuf:"bytes,1,rep,name=anchor_ids,json=anchorIds,proto3" json:"anchor_ids,omitempty"`
+	// Whether every anchor should be cleared instead of those in anchor_ids.
+	AllAnchors bool `protobuf:"varint,2,opt,name=all_anchors,json=allAnchors,proto3" json:"all_anchors,omitempty"`
+}
+
+func (x *ClearAnchorsRequest) Reset() {
+	*x = ClearAnchorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearAnchorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAnchorsRequest) ProtoMessage() {}
+
+func (x *ClearAnchorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAnchorsRequest.ProtoReflect.Descriptor instead.
+func (*ClearAnchorsRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ClearAnchorsRequest) GetAnchorIds() []string {
+	if x != nil {
+		return x.AnchorIds
+	}
+	return nil
+}
+
+func (x *ClearAnchorsRequest) GetAllAnchors() bool {
+	if x != nil {
+		return x.AllAnchors
+	}
+	return false
+}
+
+// BroadcastRequest contains request parameters for an admin rpc to send a message to all connected users
+type BroadcastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The message text.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *BroadcastRequest) Reset() {
+	*x = BroadcastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastRequest) ProtoMessage() {}
+
+func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BroadcastRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// BroadcastResponse contains the response for a BroadcastRequest.
+type BroadcastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of connected users the message will be sent to.
+	NumUsers int32 `protobuf:"varint,1,opt,name=num_users,json=numUsers,proto3" json:"num_users,omitempty"`
+}
+
+func (x *BroadcastResponse) Reset() {
+	*x = BroadcastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastResponse) ProtoMessage() {}
+
+func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *BroadcastResponse) GetNumUsers() int32 {
+	if x != nil {
+		return x.NumUsers
+	}
+	return 0
+}
+
+// SetVerboseRequest contains request parameters for an admin rpc to enable or disable verbose logging
+type SetVerboseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verbose bool `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (x *SetVerboseRequest) Reset() {
+	*x = SetVerboseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVerboseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVerboseRequest) ProtoMessage() {}
+
+func (x *SetVerboseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVerboseRequest.ProtoReflect.Descriptor instead.
+func (*SetVerboseRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetVerboseRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+// SetVerboseResponse contains the response for a SetVerboseRequest.
+type SetVerboseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether verbose logging was enabled before the request.
+	PreviousVerbose bool `protobuf:"varint,1,opt,name=previous_verbose,json=previousVerbose,proto3" json:"previous_verbose,omitempty"`
+}
+
+func (x *SetVerboseResponse) Reset() {
+	*x = SetVerboseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVerboseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVerboseResponse) ProtoMessage() {}
+
+func (x *SetVerboseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVerboseResponse.ProtoReflect.Descriptor instead.
+func (*SetVerboseResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetVerboseResponse) GetPreviousVerbose() bool {
+	if x != nil {
+		return x.PreviousVerbose
+	}
+	return false
+}
+
+// SnapshotRequest contains request parameters for an admin rpc to dump all anchors and layers
+type SnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SnapshotRequest) Reset() {
+	*x = SnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotRequest) ProtoMessage() {}
+
+func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{86}
+}
+
+// ServerSnapshotProto contains all content and layers on the server at a single point in time.
+type ServerSnapshotProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The server time in milliseconds since the unix epoch when the snapshot was taken.
+	SnapshotTimeMillis int64 `protobuf:"varint,1,opt,name=snapshot_time_millis,json=snapshotTimeMillis,proto3" json:"snapshot_time_millis,omitempty"`
+	// The version string for the server that took the snapshot.
+	ServerVersion string `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	// The content of every anchor, ordered by anchor id.
+	Anchors []*AnchorContentProto `protobuf:"bytes,3,rep,name=anchors,proto3" json:"anchors,omitempty"`
+	// All layers, ordered by name.
+	Layers []*LayerProto `protobuf:"bytes,4,rep,name=layers,proto3" json:"layers,omitempty"`
+	// The settings shared by all users.
+	RoomSettings *RoomSettingsProto `protobuf:"bytes,5,opt,name=room_settings,json=roomSettings,proto3" json:"room_settings,omitempty"`
+}
+
+func (x *ServerSnapshotProto) Reset() {
+	*x = ServerSnapshotProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSnapshotProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSnapshotProto) ProtoMessage() {}
+
+func (x *ServerSnapshotProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSnapshotProto.ProtoReflect.Descriptor instead.
+func (*ServerSnapshotProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ServerSnapshotProto) GetSnapshotTimeMillis() int64 {
+	if x != nil {
+		return x.SnapshotTimeMillis
+	}
+	return 0
+}
+
+func (x *ServerSnapshotProto) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *ServerSnapshotProto) GetAnchors() []*AnchorContentProto {
+	if x != nil {
+		return x.Anchors
+	}
+	return nil
+}
+
+func (x *ServerSnapshotProto) GetLayers() []*LayerProto {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+func (x *ServerSnapshotProto) GetRoomSettings() *RoomSettingsProto {
+	if x != nil {
+		return x.RoomSettings
+	}
+	return nil
+}
+
+// ImportRequest contains request parameters for an admin rpc to add content, layers and room settings from a dump or
+// snapshot. Content is attached to the anchor of the AnchorContentProto that contains it. Content, groups and comments
+// with an id that already exists on any anchor are skipped, since clients key them by id alone, as are comments on
+// content that doesn't exist. Grab locks are ignored.
+type ImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Anchors []*AnchorContentProto `protobuf:"bytes,1,rep,name=anchors,proto3" json:"anchors,omitempty"`
+	// Layers to add or replace.
+	Layers []*LayerProto `protobuf:"bytes,2,rep,name=layers,proto3" json:"layers,omitempty"`
+	// Optional room settings to replace the current settings.
+	RoomSettings *RoomSettingsProto `protobuf:"bytes,3,opt,name=room_settings,json=roomSettings,proto3" json:"room_settings,omitempty"`
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ImportRequest) GetAnchors() []*AnchorContentProto {
+	if x != nil {
+		return x.Anchors
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetLayers() []*LayerProto {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetRoomSettings() *RoomSettingsProto {
+	if x != nil {
+		return x.RoomSettings
+	}
+	return nil
+}
+
+// ImportResponse contains the response for an ImportRequest.
+type ImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of brush strokes, 3D models, text notes, primitives, measurements, groups and comments added.
+	NumImported int32 `protobuf:"varint,1,opt,name=num_imported,json=numImported,proto3" json:"num_imported,omitempty"`
+	// The number of brush strokes, 3D models, text notes, primitives, measurements, groups and comments skipped.
+	NumSkipped int32 `protobuf:"varint,2,opt,name=num_skipped,json=numSkipped,proto3" json:"num_skipped,omitempty"`
+	// The number of layers added or replaced.
+	NumLayers int32 `protobuf:"varint,3,opt,name=num_layers,json=numLayers,proto3" json:"num_layers,omitempty"`
+}
+
+func (x *ImportResponse) Reset() {
+	*x = ImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResponse) ProtoMessage() {}
+
+func (x *ImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ImportResponse) GetNumImported() int32 {
+	if x != nil {
+		return x.NumImported
+	}
+	return 0
+}
+
+func (x *ImportResponse) GetNumSkipped() int32 {
+	if x != nil {
+		return x.NumSkipped
+	}
+	return 0
+}
+
+func (x *ImportResponse) GetNumLayers() int32 {
+	if x != nil {
+		return x.NumLayers
+	}
+	return 0
+}
+
+// TailEventsRequest contains request parameters for an admin rpc to follow server events
+type TailEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional anchor id to only follow content events for that anchor. User events are always included.
+	AnchorId string `protobuf:"bytes,1,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+}
+
+func (x *TailEventsRequest) Reset() {
+	*x = TailEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TailEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailEventsRequest) ProtoMessage() {}
+
+func (x *TailEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailEventsRequest.ProtoReflect.Descriptor instead.
+func (*TailEventsRequest) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *TailEventsRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+// ServerEventProto describes a single user or content event on the server.
+type ServerEventProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type ServerEventProto_Type `protobuf:"varint,1,opt,name=type,proto3,enum=leapbrush.ServerEventProto_Type" json:"type,omitempty"`
+	// The server time in milliseconds since the unix epoch when the event happened.
+	TimeMillis int64 `protobuf:"varint,2,opt,name=time_millis,json=timeMillis,proto3" json:"time_millis,omitempty"`
+	// The user identifier for the user who caused the event, or who the event is about.
+	UserName string `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The anchor id for content events.
+	AnchorId string `protobuf:"bytes,4,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	// The content or comment id for content events.
+	ContentId string `protobuf:"bytes,5,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Optional human readable details, such as a 3D model's file name or the text of a broadcast.
+	Detail string `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *ServerEventProto) Reset() {
+	*x = ServerEventProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerEventProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerEventProto) ProtoMessage() {}
+
+func (x *ServerEventProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerEventProto.ProtoReflect.Descriptor instead.
+func (*ServerEventProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ServerEventProto) GetType() ServerEventProto_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ServerEventProto_USER_CONNECTED
+}
+
+func (x *ServerEventProto) GetTimeMillis() int64 {
+	if x != nil {
+		return x.TimeMillis
+	}
+	return 0
+}
+
+func (x *ServerEventProto) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ServerEventProto) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+func (x *ServerEventProto) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *ServerEventProto) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// WebhookRequestProto is the JSON body of a request sent by the server to a configured webhook url, with a batch of
+// events in the order they happened. The body is signed with the webhook secret in the X-LeapBrush-Signature header,
+// as "sha256=" followed by the hex HMAC-SHA256 of the body.
+type WebhookRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique identifier for this batch, which is unchanged when the request is retried.
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The server version that sent the events.
+	ServerVersion string              `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	Events        []*ServerEventProto `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// The number of events dropped for this url since the previous batch because the queue was full.
+	NumDropped int32 `protobuf:"varint,4,opt,name=num_dropped,json=numDropped,proto3" json:"num_dropped,omitempty"`
+}
+
+func (x *WebhookRequestProto) Reset() {
+	*x = WebhookRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequestProto) ProtoMessage() {}
+
+func (x *WebhookRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequestProto.ProtoReflect.Descriptor instead.
+func (*WebhookRequestProto) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *WebhookRequestProto) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *WebhookRequestProto) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *WebhookRequestProto) GetEvents() []*ServerEventProto {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookRequestProto) GetNumDropped() int32 {
+	if x != nil {
+		return x.NumDropped
+	}
+	return 0
+}
+
+type QueryUsersResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// User identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The space information for this user.
+	SpaceInfo *SpaceInfoProto `protobuf:"bytes,2,opt,name=space_info,json=spaceInfo,proto3" json:"space_info,omitempty"`
+	// The display name for this user.
+	UserDisplayName string `protobuf:"bytes,3,opt,name=user_display_name,json=userDisplayName,proto3" json:"user_display_name,omitempty"`
+	// The device type for this user.
+	DeviceType *UserStateProto_DeviceType `protobuf:"varint,4,opt,name=device_type,json=deviceType,proto3,enum=leapbrush.UserStateProto_DeviceType,oneof" json:"device_type,omitempty"`
+}
+
+func (x *QueryUsersResponse_Result) Reset() {
+	*x = QueryUsersResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryUsersResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUsersResponse_Result) ProtoMessage() {}
+
+func (x *QueryUsersResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUsersResponse_Result.ProtoReflect.Descriptor instead.
+func (*QueryUsersResponse_Result) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{54, 0}
+}
+
+func (x *QueryUsersResponse_Result) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *QueryUsersResponse_Result) GetSpaceInfo() *SpaceInfoProto {
+	if x != nil {
+		return x.SpaceInfo
+	}
+	return nil
+}
+
+func (x *QueryUsersResponse_Result) GetUserDisplayName() string {
+	if x != nil {
+		return x.UserDisplayName
+	}
+	return ""
+}
+
+func (x *QueryUsersResponse_Result) GetDeviceType() UserStateProto_DeviceType {
+	if x != nil && x.DeviceType != nil {
+		return *x.DeviceType
+	}
+	return UserStateProto_DESKTOP_SPECTATOR
+}
+
+type ListConnectionsResponse_Connection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// User identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The display name for this user, if the user has sent updates.
+	UserDisplayName string `protobuf:"bytes,2,opt,name=user_display_name,json=userDisplayName,proto3" json:"user_display_name,omitempty"`
+	// The client application version string, if the user has a listening stream.
+	AppVersion string `protobuf:"bytes,3,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// The device type for this user.
+	DeviceType *UserStateProto_DeviceType `protobuf:"varint,4,opt,name=device_type,json=deviceType,proto3,enum=leapbrush.UserStateProto_DeviceType,oneof" json:"device_type,omitempty"`
+	// Whether the user has a listening stream.
+	Listening bool `protobuf:"varint,5,opt,name=listening,proto3" json:"listening,omitempty"`
+	// The server time in milliseconds since the unix epoch when the listening stream connected.
+	ConnectTimeMillis int64 `protobuf:"varint,6,opt,name=connect_time_millis,json=connectTimeMillis,proto3" json:"connect_time_millis,omitempty"`
+	// Milliseconds since the server last received a state update from the user, or -1 if none has been received.
+	UpdateLagMillis int64 `protobuf:"varint,7,opt,name=update_lag_millis,json=updateLagMillis,proto3" json:"update_lag_millis,omitempty"`
+	// Number of content notifications waiting to be sent on the listening stream.
+	PendingNotifications int32 `protobuf:"varint,8,opt,name=pending_notifications,json=pendingNotifications,proto3" json:"pending_notifications,omitempty"`
+	// Total size in bytes of server state responses sent on the listening stream.
+	SentBytes uint64 `protobuf:"varint,9,opt,name=sent_bytes,json=sentBytes,proto3" json:"sent_bytes,omitempty"`
+	// The ids of the anchors currently found by the user.
+	AnchorIds []string `protobuf:"bytes,10,rep,name=anchor_ids,json=anchorIds,proto3" json:"anchor_ids,omitempty"`
+	// The name of the space the user is localized to.
+	SpaceName string `protobuf:"bytes,11,opt,name=space_name,json=spaceName,proto3" json:"space_name,omitempty"`
+	// The battery status of the user's headset, if reported.
+	HeadsetBattery *BatteryStatusProto `protobuf:"bytes,12,opt,name=headset_battery,json=headsetBattery,proto3" json:"headset_battery,omitempty"`
+}
+
+func (x *ListConnectionsResponse_Connection) Reset() {
+	*x = ListConnectionsResponse_Connection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConnectionsResponse_Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsResponse_Connection) ProtoMessage() {}
+
+func (x *ListConnectionsResponse_Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsResponse_Connection.ProtoReflect.Descriptor instead.
+func (*ListConnectionsResponse_Connection) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *ListConnectionsResponse_Connection) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ListConnectionsResponse_Connection) GetUserDisplayName() string {
+	if x != nil {
+		return x.UserDisplayName
+	}
+	return ""
+}
+
+func (x *ListConnectionsResponse_Connection) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *ListConnectionsResponse_Connection) GetDeviceType() UserStateProto_DeviceType {
+	if x != nil && x.DeviceType != nil {
+		return *x.DeviceType
+	}
+	return UserStateProto_DESKTOP_SPECTATOR
+}
+
+func (x *ListConnectionsResponse_Connection) GetListening() bool {
+	if x != nil {
+		return x.Listening
+	}
+	return false
+}
+
+func (x *ListConnectionsResponse_Connection) GetConnectTimeMillis() int64 {
+	if x != nil {
+		return x.ConnectTimeMillis
+	}
+	return 0
+}
+
+func (x *ListConnectionsResponse_Connection) GetUpdateLagMillis() int64 {
+	if x != nil {
+		return x.UpdateLagMillis
+	}
+	return 0
+}
+
+func (x *ListConnectionsResponse_Connection) GetPendingNotifications() int32 {
+	if x != nil {
+		return x.PendingNotifications
+	}
+	return 0
+}
+
+func (x *ListConnectionsResponse_Connection) GetSentBytes() uint64 {
+	if x != nil {
+		return x.SentBytes
+	}
+	return 0
+}
+
+func (x *ListConnectionsResponse_Connection) GetAnchorIds() []string {
+	if x != nil {
+		return x.AnchorIds
+	}
+	return nil
+}
+
+func (x *ListConnectionsResponse_Connection) GetSpaceName() string {
+	if x != nil {
+		return x.SpaceName
+	}
+	return ""
+}
+
+func (x *ListConnectionsResponse_Connection) GetHeadsetBattery() *BatteryStatusProto {
+	if x != nil {
+		return x.HeadsetBattery
+	}
+	return nil
+}
+
+type ListConnectionsResponse_BannedUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// User identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The reason given when the user was banned.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ListConnectionsResponse_BannedUser) Reset() {
+	*x = ListConnectionsResponse_BannedUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConnectionsResponse_BannedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsResponse_BannedUser) ProtoMessage() {}
+
+func (x *ListConnectionsResponse_BannedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsResponse_BannedUser.ProtoReflect.Descriptor instead.
+func (*ListConnectionsResponse_BannedUser) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{72, 1}
+}
+
+func (x *ListConnectionsResponse_BannedUser) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ListConnectionsResponse_BannedUser) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListAnchorsResponse_Anchor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The spatial anchor identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The users that have currently found this anchor, ordered by user name.
+	UserNames        []string `protobuf:"bytes,2,rep,name=user_names,json=userNames,proto3" json:"user_names,omitempty"`
+	BrushStrokeCount int32    `protobuf:"varint,3,opt,name=brush_stroke_count,json=brushStrokeCount,proto3" json:"brush_stroke_count,omitempty"`
+	// The total number of poses in all brush strokes attached to this anchor.
+	BrushPoseCount     int32 `protobuf:"varint,4,opt,name=brush_pose_count,json=brushPoseCount,proto3" json:"brush_pose_count,omitempty"`
+	ExternalModelCount int32 `protobuf:"varint,5,opt,name=external_model_count,json=externalModelCount,proto3" json:"external_model_count,omitempty"`
+	TextNoteCount      int32 `protobuf:"varint,6,opt,name=text_note_count,json=textNoteCount,proto3" json:"text_note_count,omitempty"`
+	PrimitiveCount     int32 `protobuf:"varint,7,opt,name=primitive_count,json=primitiveCount,proto3" json:"primitive_count,omitempty"`
+	MeasurementCount   int32 `protobuf:"varint,8,opt,name=measurement_count,json=measurementCount,proto3" json:"measurement_count,omitempty"`
+	ContentGroupCount  int32 `protobuf:"varint,9,opt,name=content_group_count,json=contentGroupCount,proto3" json:"content_group_count,omitempty"`
+	CommentCount       int32 `protobuf:"varint,10,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+}
+
+func (x *ListAnchorsResponse_Anchor) Reset() {
+	*x = ListAnchorsResponse_Anchor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_leap_brush_api_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnchorsResponse_Anchor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnchorsResponse_Anchor) ProtoMessage() {}
+
+func (x *ListAnchorsResponse_Anchor) ProtoReflect() protoreflect.Message {
+	mi := &file_leap_brush_api_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnchorsResponse_Anchor.ProtoReflect.Descriptor instead.
+func (*ListAnchorsResponse_Anchor) Descriptor() ([]byte, []int) {
+	return file_leap_brush_api_proto_rawDescGZIP(), []int{78, 0}
+}
+
+func (x *ListAnchorsResponse_Anchor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListAnchorsResponse_Anchor) GetUserNames() []string {
+	if x != nil {
+		return x.UserNames
+	}
+	return nil
+}
+
+func (x *ListAnchorsResponse_Anchor) GetBrushStrokeCount() int32 {
+	if x != nil {
+		return x.BrushStrokeCount
+	}
+	return 0
+}
+
+func (x *ListAnchorsResponse_Anchor) GetBrushPoseCount() int32 {
+	if x != nil {
+		return x.BrushPoseCount
+	}
+	return 0
+}
+
+func (x *ListAnchorsResponse_Anchor) GetExternalModelCount() int32 {
+	if x != nil {
+		return x.ExternalModelCount
+	}
+	return 0
+}
+
+func (x *ListAnchorsResponse_Anchor) GetTextNoteCount() int32 {
+	if x != nil {
+		return x.TextNoteCount
+	}
+	return 0
+}
+
+func (x *ListAnchorsResponse_Anchor) GetPrimitiveCount() int32 {
+	if x != nil {
+		return x.PrimitiveCount
+	}
+	return 0
+}
+
+func (x *ListAnchorsResponse_Anchor) GetMeasurementCount() int32 {
+	if x != nil {
+		return x.MeasurementCount
+	}
+	return 0
+}
+
+func (x *ListAnchorsResponse_Anchor) GetContentGroupCount() int32 {
+	if x != nil {
+		return x.ContentGroupCount
+	}
+	return 0
+}
+
+func (x *ListAnchorsResponse_Anchor) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+var File_leap_brush_api_proto protoreflect.FileDescriptor
+
+var file_leap_brush_api_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x6c, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x22, 0x38, 0x0a, 0x0c, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a,
+	0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x7a, 0x22, 0x49, 0x0a, 0x0f, 0x51,
+	0x75, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
+	0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x7a, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x01, 0x77, 0x22, 0x78, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xac, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2d, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0xc4, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x40, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x56,
+	0x0a, 0x0c, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x49, 0x53,
+	0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f,
+	0x54, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04,
+	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x04, 0x22, 0xc5, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x28, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0b, 0x74, 0x6f, 0x6f, 0x6c, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5a, 0x12, 0x36, 0x0a,
+	0x0a, 0x72, 0x61, 0x79, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x72, 0x61, 0x79, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa4,
+	0x01, 0x0a, 0x0e, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x31, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68,
+	0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x74, 0x6f, 0x6f, 0x6c,
+	0x50, 0x6f, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x72, 0x61, 0x79, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x09, 0x72, 0x61, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf8, 0x06, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x68, 0x65, 0x61,
+	0x64, 0x50, 0x6f, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x68,
+	0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x48, 0x61, 0x6e, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x65,
+	0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48,
+	0x0a, 0x10, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x48, 0x02, 0x52, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x48, 0x61, 0x6e, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x72, 0x67, 0x62, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52,
+	0x67, 0x62, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75,
+	0x73, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a,
+	0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0f, 0x68, 0x65,
+	0x61, 0x64, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x48, 0x04, 0x52, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x73, 0x65, 0x74, 0x42, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x22, 0x50, 0x0a, 0x09, 0x54, 0x6f, 0x6f, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x45, 0x4e, 0x55, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x4c, 0x41, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x52, 0x41,
+	0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x52, 0x55, 0x53, 0x48, 0x5f, 0x53,
+	0x43, 0x52, 0x49, 0x42, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x52, 0x55,
+	0x53, 0x48, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x10, 0x04, 0x22, 0x33, 0x0a, 0x0a, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x53, 0x4b, 0x54,
+	0x4f, 0x50, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4d, 0x41, 0x47, 0x49, 0x43, 0x5f, 0x4c, 0x45, 0x41, 0x50, 0x10, 0x01, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x68, 0x61, 0x6e,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x22, 0x47, 0x0a, 0x0b, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x28, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x22, 0xec, 0x02, 0x0a, 0x0e, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2e, 0x0a, 0x06,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x13,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0c,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x2a, 0x0a, 0x0b,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4f,
+	0x4e, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x52,
+	0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x10, 0x01, 0x22, 0xf9, 0x03, 0x0a, 0x10, 0x42, 0x72, 0x75,
+	0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x70, 0x6f, 0x73, 0x65,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x6f,
+	0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x72, 0x67, 0x62, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52,
+	0x67, 0x62, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x5f, 0x72, 0x67, 0x62, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x69, 0x6c,
+	0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x67, 0x62, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x69,
+	0x6c, 0x6c, 0x5f, 0x64, 0x69, 0x6d, 0x6d, 0x65, 0x72, 0x5f, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x6c, 0x44, 0x69, 0x6d, 0x6d, 0x65, 0x72, 0x41, 0x12, 0x37,
+	0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x23, 0x0a, 0x09, 0x42, 0x72, 0x75, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x43, 0x52, 0x49, 0x42, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f,
+	0x4c, 0x59, 0x10, 0x01, 0x22, 0xf6, 0x02, 0x0a, 0x12, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x31,
+	0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x3c, 0x0a, 0x09, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x09, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xdb, 0x01,
+	0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x1b,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x18, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0xef, 0x03, 0x0a, 0x0d,
+	0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x6f, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
+	0x66, 0x6f, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x72, 0x67, 0x62, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x67, 0x62, 0x61,
+	0x12, 0x32, 0x0a, 0x15, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x72, 0x67, 0x62, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x52, 0x67, 0x62, 0x61, 0x12, 0x4d, 0x0a, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x6f,
+	0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x0d, 0x42,
+	0x69, 0x6c, 0x6c, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x56, 0x45, 0x52, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x22, 0x93, 0x01,
+	0x0a, 0x13, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x72,
+	0x67, 0x62, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x52, 0x67, 0x62, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x5f, 0x72, 0x67, 0x62, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x69,
+	0x6c, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x67, 0x62, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x22, 0x89, 0x04, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x35, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x72, 0x69, 0x6d,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68, 0x61, 0x70, 0x65,
+	0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x34,
+	0x0a, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x73,
+	0x74, 0x79, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x6d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x52, 0x0a, 0x05, 0x53,
+	0x68, 0x61, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x41, 0x52, 0x52, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x45, 0x41,
+	0x53, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
+	0x42, 0x4f, 0x58, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x50, 0x48, 0x45, 0x52, 0x45, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x59, 0x4c, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x05, 0x22,
+	0xb8, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x48, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12,
+	0x31, 0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x26, 0x0a, 0x0a, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x49, 0x4d, 0x50, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x22, 0xbd, 0x04, 0x0a, 0x10, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0d, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x02, 0x52, 0x0c, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65,
+	0x73, 0x12, 0x48, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x0a, 0x75, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2c, 0x0a,
+	0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x72, 0x65, 0x61, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x72, 0x65, 0x61, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xf0, 0x02, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0xa2, 0x03,
+	0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x28, 0x0a,
+	0x10, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74,
+	0x72, 0x6f, 0x6b, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x31, 0x0a,
+	0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x49, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69,
+	0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x0a, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x0b, 0x75, 0x73, 0x65, 0x72, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x31, 0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x22, 0x3f, 0x0a, 0x0e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70,
+	0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x15, 0x42,
+	0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74,
+	0x72, 0x6f, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f,
+	0x6b, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74,
+	0x72, 0x6f, 0x6b, 0x65, 0x22, 0x6c, 0x0a, 0x18, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72,
+	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x65, 0x70, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x1b, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f,
+	0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x37, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x17, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x6e, 0x0a, 0x1a, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x65, 0x70,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x4b, 0x0a, 0x12, 0x54, 0x65, 0x78, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35,
+	0x0a, 0x09, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x54, 0x65,
+	0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x74, 0x65, 0x78,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x22, 0x44, 0x0a, 0x15, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x13, 0x50,
+	0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x09, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0x45, 0x0a, 0x16, 0x50,
+	0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x49, 0x64, 0x22, 0x56, 0x0a, 0x15, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x18, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x62, 0x4c, 0x6f, 0x63, 0x6b,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x4d, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x62,
+	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x43, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0xc3, 0x05, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12,
+	0x1b, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x01, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x14,
+	0x61, 0x64, 0x64, 0x5f, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x64, 0x42,
+	0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a,
+	0x17, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74,
+	0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b,
+	0x65, 0x49, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x64, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x64, 0x64, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x2f, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x64, 0x64,
+	0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x61, 0x64, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x64,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x34,
+	0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x12, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x61, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x72, 0x75, 0x73, 0x68,
+	0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x49, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x73, 0x22, 0x13, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe4, 0x03, 0x0a, 0x12, 0x45, 0x72, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x68,
+	0x61, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x05,
+	0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x50, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x62, 0x6f, 0x78, 0x5f, 0x68,
+	0x61, 0x6c, 0x66, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0e, 0x62, 0x6f, 0x78, 0x48,
+	0x61, 0x6c, 0x66, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x6d,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12,
+	0x31, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x29, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x50, 0x48, 0x45, 0x52, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4f, 0x58, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x41, 0x50, 0x53, 0x55, 0x4c, 0x45, 0x10, 0x02, 0x22, 0xaa, 0x02,
+	0x0a, 0x13, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x49, 0x64, 0x73, 0x12, 0x3b,
+	0x0a, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x10,
+	0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x72, 0x67, 0x62,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x67, 0x62, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x10, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x54, 0x68, 0x61, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f,
+	0x72, 0x67, 0x62, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x68,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x18, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0xab, 0x02, 0x0a, 0x14, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x31,
+	0x0a, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69,
+	0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x52, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x7d, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x22, 0x4c, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbe,
+	0x02, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xe7, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a,
+	0x0a, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x60, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e,
+	0x5f, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x55, 0x0a, 0x15, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x74, 0x0a, 0x13, 0x53, 0x68, 0x75, 0x74,
+	0x64, 0x6f, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x30, 0x0a, 0x14, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73,
+	0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xee,
+	0x0d, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x4a, 0x0a, 0x10, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f,
+	0x6b, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x12, 0x53, 0x0a, 0x13,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b,
+	0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x12, 0x50, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x41, 0x64, 0x64, 0x12, 0x59, 0x0a, 0x15, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5c, 0x0a, 0x16, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72,
+	0x6f, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x14, 0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x4d, 0x0a, 0x11, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x12, 0x56, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x12, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0x38, 0x0a, 0x0a, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68,
+	0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x09, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x74, 0x65,
+	0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x54, 0x65,
+	0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x12, 0x4a, 0x0a,
+	0x10, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x74, 0x65, 0x78, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0x43, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x64,
+	0x64, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x41, 0x64, 0x64, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x72, 0x69, 0x6d,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x6d, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64,
+	0x64, 0x12, 0x52, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x62, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x61, 0x64, 0x64, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x62, 0x4c, 0x6f, 0x63, 0x6b, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x62, 0x4c, 0x6f, 0x63, 0x6b, 0x41, 0x64,
+	0x64, 0x12, 0x44, 0x0a, 0x10, 0x67, 0x72, 0x61, 0x62, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x62, 0x4c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x67, 0x72, 0x61, 0x62, 0x4c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x73, 0x68, 0x75, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x0e, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
+	0x12, 0x4d, 0x0a, 0x11, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x10, 0x62,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xa0, 0x0c, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x38, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x65,
+	0x63, 0x68, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x65, 0x63, 0x68, 0x6f, 0x12,
+	0x4a, 0x0a, 0x10, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f,
+	0x61, 0x64, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b,
+	0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x12, 0x53, 0x0a, 0x13, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0x50, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41,
+	0x64, 0x64, 0x12, 0x59, 0x0a, 0x15, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x5c, 0x0a,
+	0x16, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53,
+	0x74, 0x72, 0x6f, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x14, 0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f,
+	0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x4d, 0x0a, 0x11, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x12, 0x56, 0x0a, 0x14, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x12,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x74, 0x65,
+	0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x54, 0x65,
+	0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x12, 0x4a, 0x0a,
+	0x10, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x74, 0x65, 0x78, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0x43, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x64,
+	0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x41, 0x64, 0x64, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x72, 0x69, 0x6d,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x12, 0x52,
+	0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x62, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x62, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x67, 0x72, 0x61, 0x62, 0x4c,
+	0x6f, 0x63, 0x6b, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x67, 0x72,
+	0x61, 0x62, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x47, 0x72, 0x61, 0x62, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0f, 0x67, 0x72, 0x61, 0x62, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x14, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x5d, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x57,
+	0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xd4, 0x03, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x5f,
+	0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x09, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x4d, 0x69, 0x6e, 0x12, 0x36, 0x0a,
+	0x0a, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x4d, 0x61, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74,
+	0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x40, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x27, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x0c, 0x0a, 0x08, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x44, 0x49, 0x52, 0x10, 0x01, 0x22, 0x2c,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x56, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x22, 0x56, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x67, 0x0a, 0x15,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf7, 0x07, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x4c, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4f, 0x0a, 0x14, 0x65, 0x72, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x12, 0x65, 0x72,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x52, 0x0a, 0x15, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x13, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x1b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6c,
+	0x65, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x18,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x5f,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x53, 0x65, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0f, 0x73, 0x65, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4f, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x12, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x65, 0x0a, 0x1c, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x19, 0x73,
+	0x65, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x19, 0x73, 0x65, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x16,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x16, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x14, 0x71, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5c, 0x0a,
+	0x19, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x65, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x16, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x1b, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x18, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xca, 0x03, 0x0a, 0x0b, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4f, 0x0a, 0x14, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x12, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x15, 0x65, 0x72, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x72, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x13, 0x65, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x16, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x14, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x17, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x15,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x19, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa8, 0x06, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x0c, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x1a, 0xa6, 0x04, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x73,
+	0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4a,
+	0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6c, 0x61, 0x67, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x67, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e,
+	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x73, 0x65,
+	0x74, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0e,
+	0x68, 0x65, 0x61, 0x64, 0x73, 0x65, 0x74, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x41,
+	0x0a, 0x0a, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x58, 0x0a, 0x0f, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x62, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x10, 0x4b,
+	0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x77, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x10, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61,
+	0x73, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x77, 0x61, 0x73, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xed, 0x03, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52,
+	0x07, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x1a, 0x94, 0x03, 0x0a, 0x06, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x6f,
+	0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x50, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70,
+	0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a,
+	0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x30, 0x0a, 0x11, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49,
+	0x64, 0x22, 0xa3, 0x04, 0x0a, 0x12, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x62, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x73,
+	0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x75, 0x73, 0x68, 0x53, 0x74,
+	0x72, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x62, 0x72, 0x75, 0x73, 0x68,
+	0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12,
+	0x37, 0x0a, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x54, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x74,
+	0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37,
+	0x0a, 0x0a, 0x67, 0x72, 0x61, 0x62, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x47,
+	0x72, 0x61, 0x62, 0x4c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x67, 0x72,
+	0x61, 0x62, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x6c, 0x6c, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x26,
+	0x0a, 0x10, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x30, 0x0a, 0x11, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x75, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6e, 0x75, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x56,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x56, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x13,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x07,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x6d, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x41, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x73, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75,
+	0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d,
+	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6e, 0x75, 0x6d, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75,
+	0x6d, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x6e, 0x75, 0x6d, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x54, 0x61, 0x69,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x22, 0xe6, 0x04, 0x0a, 0x10,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x89, 0x03, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x49,
+	0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a,
+	0x09, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12,
+	0x42, 0x52, 0x55, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x52, 0x4f, 0x4b, 0x45, 0x5f, 0x41, 0x44, 0x44,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x52, 0x55, 0x53, 0x48, 0x5f, 0x53, 0x54,
+	0x52, 0x4f, 0x4b, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18,
+	0x0a, 0x14, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c,
+	0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x4e, 0x4f, 0x54,
+	0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x45, 0x58,
+	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x09,
+	0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x49, 0x4d, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x41, 0x44,
+	0x44, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x49, 0x4d, 0x49, 0x54, 0x49,
+	0x56, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11,
+	0x4d, 0x45, 0x41, 0x53, 0x55, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x45,
+	0x44, 0x10, 0x0c, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x41, 0x53, 0x55, 0x52, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x11, 0x0a, 0x0d,
+	0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x0e, 0x12,
+	0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x0f, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53,
+	0x54, 0x10, 0x10, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x54, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x4c,
+	0x4f, 0x57, 0x10, 0x11, 0x22, 0xad, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x44, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x32, 0xa6, 0x03, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x70, 0x42, 0x72, 0x75,
+	0x73, 0x68, 0x41, 0x70, 0x69, 0x12, 0x59, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x41, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x59, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x36, 0x0a, 0x03, 0x52,
+	0x70, 0x63, 0x12, 0x15, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52,
+	0x70, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x56, 0x0a, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xdc, 0x06,
+	0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x70, 0x42, 0x72, 0x75, 0x73, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x12, 0x5a, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75,
+	0x73, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08,
+	0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68,
+	0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x1b, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x6e, 0x62,
+	0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x0a, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x1c, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x09, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6c, 0x65, 0x61,
+	0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72,
+	0x75, 0x73, 0x68, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x56, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73,
+	0x68, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e,
+	0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x1a, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c,
+	0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62,
+	0x72, 0x75, 0x73, 0x68, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x0a, 0x54, 0x61, 0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e,
+	0x6c, 0x65, 0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x65,
+	0x61, 0x70, 0x62, 0x72, 0x75, 0x73, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x42, 0x48, 0x5a, 0x30,
+	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x6c, 0x65, 0x61, 0x70,
+	0x2e, 0x69, 0x6f, 0x2f, 0x67, 0x68, 0x61, 0x7a, 0x65, 0x6e, 0x2f, 0x6c, 0x65, 0x61, 0x70, 0x2d,
+	0x62, 0x72, 0x75, 0x73, 0x68, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0xaa, 0x02, 0x13, 0x4d, 0x61, 0x67, 0x69, 0x63, 0x4c, 0x65, 0x61, 0x70, 0x2e, 0x4c, 0x65, 0x61,
+	0x70, 0x42, 0x72, 0x75, 0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_leap_brush_api_proto_rawDescOnce sync.Once
+	file_leap_brush_api_proto_rawDescData = file_leap_brush_api_proto_rawDesc
+)
+
+func file_leap_brush_api_proto_rawDescGZIP() []byte {
+	file_leap_brush_api_proto_rawDescOnce.Do(func() {
+		file_leap_brush_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_leap_brush_api_proto_rawDescData)
+	})
+	return file_leap_brush_api_proto_rawDescData
+}
+
+var file_leap_brush_api_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_leap_brush_api_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
+var file_leap_brush_api_proto_goTypes = []interface{}{
+	(BatteryStatusProto_BatteryState)(0),       // 0: leapbrush.BatteryStatusProto.BatteryState
+	(UserStateProto_ToolState)(0),              // 1: leapbrush.UserStateProto.ToolState
+	(UserStateProto_DeviceType)(0),             // 2: leapbrush.UserStateProto.DeviceType
+	(SpaceInfoProto_MappingMode)(0),            // 3: leapbrush.SpaceInfoProto.MappingMode
+	(BrushStrokeProto_BrushType)(0),            // 4: leapbrush.BrushStrokeProto.BrushType
+	(TextNoteProto_BillboardMode)(0),           // 5: leapbrush.TextNoteProto.BillboardMode
+	(PrimitiveProto_Shape)(0),                  // 6: leapbrush.PrimitiveProto.Shape
+	(RoomSettingsProto_UnitSystem)(0),          // 7: leapbrush.RoomSettingsProto.UnitSystem
+	(EraseRegionRequest_Shape)(0),              // 8: leapbrush.EraseRegionRequest.Shape
+	(ModelCatalogEntryProto_Source)(0),         // 9: leapbrush.ModelCatalogEntryProto.Source
+	(ServerEventProto_Type)(0),                 // 10: leapbrush.ServerEventProto.Type
+	(*Vector3Proto)(nil),                       // 11: leapbrush.Vector3Proto
+	(*QuaternionProto)(nil),                    // 12: leapbrush.QuaternionProto
+	(*PoseProto)(nil),                          // 13: leapbrush.PoseProto
+	(*TransformProto)(nil),                     // 14: leapbrush.TransformProto
+	(*BatteryStatusProto)(nil),                 // 15: leapbrush.BatteryStatusProto
+	(*ControllerStateProto)(nil),               // 16: leapbrush.ControllerStateProto
+	(*HandStateProto)(nil),                     // 17: leapbrush.HandStateProto
+	(*UserStateProto)(nil),                     // 18: leapbrush.UserStateProto
+	(*AnchorProto)(nil),                        // 19: leapbrush.AnchorProto
+	(*SpaceInfoProto)(nil),                     // 20: leapbrush.SpaceInfoProto
+	(*BrushStrokeProto)(nil),                   // 21: leapbrush.BrushStrokeProto
+	(*ExternalModelProto)(nil),                 // 22: leapbrush.ExternalModelProto
+	(*ModelAnimationProto)(nil),                // 23: leapbrush.ModelAnimationProto
+	(*TextNoteProto)(nil),                      // 24: leapbrush.TextNoteProto
+	(*PrimitiveStyleProto)(nil),                // 25: leapbrush.PrimitiveStyleProto
+	(*PrimitiveProto)(nil),                     // 26: leapbrush.PrimitiveProto
+	(*RoomSettingsProto)(nil),                  // 27: leapbrush.RoomSettingsProto
+	(*MeasurementProto)(nil),                   // 28: leapbrush.MeasurementProto
+	(*CommentProto)(nil),                       // 29: leapbrush.CommentProto
+	(*ContentGroupProto)(nil),                  // 30: leapbrush.ContentGroupProto
+	(*LayerProto)(nil),                         // 31: leapbrush.LayerProto
+	(*LayerListProto)(nil),                     // 32: leapbrush.LayerListProto
+	(*RegisterDeviceRequest)(nil),              // 33: leapbrush.RegisterDeviceRequest
+	(*BrushStrokeAddRequest)(nil),              // 34: leapbrush.BrushStrokeAddRequest
+	(*BrushStrokeRemoveRequest)(nil),           // 35: leapbrush.BrushStrokeRemoveRequest
+	(*BrushStrokeTransformRequest)(nil),        // 36: leapbrush.BrushStrokeTransformRequest
+	(*ExternalModelAddRequest)(nil),            // 37: leapbrush.ExternalModelAddRequest
+	(*ExternalModelRemoveRequest)(nil),         // 38: leapbrush.ExternalModelRemoveRequest
+	(*TextNoteAddRequest)(nil),                 // 39: leapbrush.TextNoteAddRequest
+	(*TextNoteRemoveRequest)(nil),              // 40: leapbrush.TextNoteRemoveRequest
+	(*PrimitiveAddRequest)(nil),                // 41: leapbrush.PrimitiveAddRequest
+	(*PrimitiveRemoveRequest)(nil),             // 42: leapbrush.PrimitiveRemoveRequest
+	(*MeasurementAddRequest)(nil),              // 43: leapbrush.MeasurementAddRequest
+	(*MeasurementRemoveRequest)(nil),           // 44: leapbrush.MeasurementRemoveRequest
+	(*GrabLockProto)(nil),                      // 45: leapbrush.GrabLockProto
+	(*GrabLockRequest)(nil),                    // 46: leapbrush.GrabLockRequest
+	(*CommentAddRequest)(nil),                  // 47: leapbrush.CommentAddRequest
+	(*CommentRemoveRequest)(nil),               // 48: leapbrush.CommentRemoveRequest
+	(*ContentGroupAddRequest)(nil),             // 49: leapbrush.ContentGroupAddRequest
+	(*ContentGroupUpdateRequest)(nil),          // 50: leapbrush.ContentGroupUpdateRequest
+	(*ContentGroupRemoveRequest)(nil),          // 51: leapbrush.ContentGroupRemoveRequest
+	(*SetLayerRequest)(nil),                    // 52: leapbrush.SetLayerRequest
+	(*RemoveLayerRequest)(nil),                 // 53: leapbrush.RemoveLayerRequest
+	(*SetLayerVisibilityRequest)(nil),          // 54: leapbrush.SetLayerVisibilityRequest
+	(*SetContentLayerRequest)(nil),             // 55: leapbrush.SetContentLayerRequest
+	(*QueryUsersRequest)(nil),                  // 56: leapbrush.QueryUsersRequest
+	(*EraseRegionRequest)(nil),                 // 57: leapbrush.EraseRegionRequest
+	(*EraseRegionResponse)(nil),                // 58: leapbrush.EraseRegionResponse
+	(*ClearContentRequest)(nil),                // 59: leapbrush.ClearContentRequest
+	(*AdminClearContentRequest)(nil),           // 60: leapbrush.AdminClearContentRequest
+	(*ClearContentResponse)(nil),               // 61: leapbrush.ClearContentResponse
+	(*SetRoomSettingsRequest)(nil),             // 62: leapbrush.SetRoomSettingsRequest
+	(*QueryCommentsRequest)(nil),               // 63: leapbrush.QueryCommentsRequest
+	(*QueryCommentsResponse)(nil),              // 64: leapbrush.QueryCommentsResponse
+	(*QueryUsersResponse)(nil),                 // 65: leapbrush.QueryUsersResponse
+	(*ServerInfoProto)(nil),                    // 66: leapbrush.ServerInfoProto
+	(*BroadcastMessageProto)(nil),              // 67: leapbrush.BroadcastMessageProto
+	(*ShutdownNoticeProto)(nil),                // 68: leapbrush.ShutdownNoticeProto
+	(*ServerStateResponse)(nil),                // 69: leapbrush.ServerStateResponse
+	(*UpdateDeviceRequest)(nil),                // 70: leapbrush.UpdateDeviceRequest
+	(*UpdateDeviceResponse)(nil),               // 71: leapbrush.UpdateDeviceResponse
+	(*ModelUploadInfoProto)(nil),               // 72: leapbrush.ModelUploadInfoProto
+	(*UploadModelRequest)(nil),                 // 73: leapbrush.UploadModelRequest
+	(*UploadModelResponse)(nil),                // 74: leapbrush.UploadModelResponse
+	(*ModelCatalogEntryProto)(nil),             // 75: leapbrush.ModelCatalogEntryProto
+	(*QueryModelCatalogRequest)(nil),           // 76: leapbrush.QueryModelCatalogRequest
+	(*QueryModelCatalogResponse)(nil),          // 77: leapbrush.QueryModelCatalogResponse
+	(*DownloadModelRequest)(nil),               // 78: leapbrush.DownloadModelRequest
+	(*DownloadModelResponse)(nil),              // 79: leapbrush.DownloadModelResponse
+	(*RpcRequest)(nil),                         // 80: leapbrush.RpcRequest
+	(*RpcResponse)(nil),                        // 81: leapbrush.RpcResponse
+	(*ListConnectionsRequest)(nil),             // 82: leapbrush.ListConnectionsRequest
+	(*ListConnectionsResponse)(nil),            // 83: leapbrush.ListConnectionsResponse
+	(*KickUserRequest)(nil),                    // 84: leapbrush.KickUserRequest
+	(*KickUserResponse)(nil),                   // 85: leapbrush.KickUserResponse
+	(*UnbanUserRequest)(nil),                   // 86: leapbrush.UnbanUserRequest
+	(*UnbanUserResponse)(nil),                  // 87: leapbrush.UnbanUserResponse
+	(*ListAnchorsRequest)(nil),                 // 88: leapbrush.ListAnchorsRequest
+	(*ListAnchorsResponse)(nil),                // 89: leapbrush.ListAnchorsResponse
+	(*DumpAnchorRequest)(nil),                  // 90: leapbrush.DumpAnchorRequest
+	(*AnchorContentProto)(nil),                 // 91: leapbrush.AnchorContentProto
+	(*ClearAnchorsRequest)(nil),                // 92: leapbrush.ClearAnchorsRequest
+	(*BroadcastRequest)(nil),                   // 93: leapbrush.BroadcastRequest
+	(*BroadcastResponse)(nil),                  // 94: leapbrush.BroadcastResponse
+	(*SetVerboseRequest)(nil),                  // 95: leapbrush.SetVerboseRequest
+	(*SetVerboseResponse)(nil),                 // 96: leapbrush.SetVerboseResponse
+	(*SnapshotRequest)(nil),                    // 97: leapbrush.SnapshotRequest
+	(*ServerSnapshotProto)(nil),                // 98: leapbrush.ServerSnapshotProto
+	(*ImportRequest)(nil),                      // 99: leapbrush.ImportRequest
+	(*ImportResponse)(nil),                     // 100: leapbrush.ImportResponse
+	(*TailEventsRequest)(nil),                  // 101: leapbrush.TailEventsRequest
+	(*ServerEventProto)(nil),                   // 102: leapbrush.ServerEventProto
+	(*WebhookRequestProto)(nil),                // 103: leapbrush.WebhookRequestProto
+	(*QueryUsersResponse_Result)(nil),          // 104: leapbrush.QueryUsersResponse.Result
+	(*ListConnectionsResponse_Connection)(nil), // 105: leapbrush.ListConnectionsResponse.Connection
+	(*ListConnectionsResponse_BannedUser)(nil), // 106: leapbrush.ListConnectionsResponse.BannedUser
+	(*ListAnchorsResponse_Anchor)(nil),         // 107: leapbrush.ListAnchorsResponse.Anchor
+}
+var file_leap_brush_api_proto_depIdxs = []int32{
+	11,  // 0: leapbrush.PoseProto.position:type_name -> leapbrush.Vector3Proto
+	12,  // 1: leapbrush.PoseProto.rotation:type_name -> leapbrush.QuaternionProto
+	11,  // 2: leapbrush.TransformProto.position:type_name -> leapbrush.Vector3Proto
+	12,  // 3: leapbrush.TransformProto.rotation:type_name -> leapbrush.QuaternionProto
+	11,  // 4: leapbrush.TransformProto.scale:type_name -> leapbrush.Vector3Proto
+	0,   // 5: leapbrush.BatteryStatusProto.state:type_name -> leapbrush.BatteryStatusProto.BatteryState
+	13,  // 6: leapbrush.ControllerStateProto.pose:type_name -> leapbrush.PoseProto
+	11,  // 7: leapbrush.ControllerStateProto.ray_points:type_name -> leapbrush.Vector3Proto
+	13,  // 8: leapbrush.HandStateProto.tool_pose:type_name -> leapbrush.PoseProto
+	11,  // 9: leapbrush.HandStateProto.ray_points:type_name -> leapbrush.Vector3Proto
+	13,  // 10: leapbrush.UserStateProto.head_pose:type_name -> leapbrush.PoseProto
+	16,  // 11: leapbrush.UserStateProto.controller_state:type_name -> leapbrush.ControllerStateProto
+	17,  // 12: leapbrush.UserStateProto.left_hand_state:type_name -> leapbrush.HandStateProto
+	17,  // 13: leapbrush.UserStateProto.right_hand_state:type_name -> leapbrush.HandStateProto
+	1,   // 14: leapbrush.UserStateProto.tool_state:type_name -> leapbrush.UserStateProto.ToolState
+	2,   // 15: leapbrush.UserStateProto.device_type:type_name -> leapbrush.UserStateProto.DeviceType
+	15,  // 16: leapbrush.UserStateProto.headset_battery:type_name -> leapbrush.BatteryStatusProto
+	13,  // 17: leapbrush.AnchorProto.pose:type_name -> leapbrush.PoseProto
+	19,  // 18: leapbrush.SpaceInfoProto.anchor:type_name -> leapbrush.AnchorProto
+	13,  // 19: leapbrush.SpaceInfoProto.target_space_origin:type_name -> leapbrush.PoseProto
+	3,   // 20: leapbrush.SpaceInfoProto.mapping_mode:type_name -> leapbrush.SpaceInfoProto.MappingMode
+	4,   // 21: leapbrush.BrushStrokeProto.type:type_name -> leapbrush.BrushStrokeProto.BrushType
+	13,  // 22: leapbrush.BrushStrokeProto.brush_pose:type_name -> leapbrush.PoseProto
+	14,  // 23: leapbrush.BrushStrokeProto.transform:type_name -> leapbrush.TransformProto
+	14,  // 24: leapbrush.ExternalModelProto.transform:type_name -> leapbrush.TransformProto
+	23,  // 25: leapbrush.ExternalModelProto.animation:type_name -> leapbrush.ModelAnimationProto
+	5,   // 26: leapbrush.TextNoteProto.billboard_mode:type_name -> leapbrush.TextNoteProto.BillboardMode
+	13,  // 27: leapbrush.TextNoteProto.pose:type_name -> leapbrush.PoseProto
+	6,   // 28: leapbrush.PrimitiveProto.shape:type_name -> leapbrush.PrimitiveProto.Shape
+	11,  // 29: leapbrush.PrimitiveProto.control_point:type_name -> leapbrush.Vector3Proto
+	14,  // 30: leapbrush.PrimitiveProto.transform:type_name -> leapbrush.TransformProto
+	25,  // 31: leapbrush.PrimitiveProto.style:type_name -> leapbrush.PrimitiveStyleProto
+	7,   // 32: leapbrush.RoomSettingsProto.unit_system:type_name -> leapbrush.RoomSettingsProto.UnitSystem
+	11,  // 33: leapbrush.MeasurementProto.point:type_name -> leapbrush.Vector3Proto
+	7,   // 34: leapbrush.MeasurementProto.unit_system:type_name -> leapbrush.RoomSettingsProto.UnitSystem
+	14,  // 35: leapbrush.ContentGroupProto.transform:type_name -> leapbrush.TransformProto
+	31,  // 36: leapbrush.LayerListProto.layers:type_name -> leapbrush.LayerProto
+	21,  // 37: leapbrush.BrushStrokeAddRequest.brush_stroke:type_name -> leapbrush.BrushStrokeProto
+	14,  // 38: leapbrush.BrushStrokeTransformRequest.transform:type_name -> leapbrush.TransformProto
+	22,  // 39: leapbrush.ExternalModelAddRequest.model:type_name -> leapbrush.ExternalModelProto
+	24,  // 40: leapbrush.TextNoteAddRequest.text_note:type_name -> leapbrush.TextNoteProto
+	26,  // 41: leapbrush.PrimitiveAddRequest.primitive:type_name -> leapbrush.PrimitiveProto
+	28,  // 42: leapbrush.MeasurementAddRequest.measurement:type_name -> leapbrush.MeasurementProto
+	29,  // 43: leapbrush.CommentAddRequest.comment:type_name -> leapbrush.CommentProto
+	30,  // 44: leapbrush.ContentGroupAddRequest.group:type_name -> leapbrush.ContentGroupProto
+	14,  // 45: leapbrush.ContentGroupUpdateRequest.transform:type_name -> leapbrush.TransformProto
+	31,  // 46: leapbrush.SetLayerRequest.layer:type_name -> leapbrush.LayerProto
+	8,   // 47: leapbrush.EraseRegionRequest.shape:type_name -> leapbrush.EraseRegionRequest.Shape
+	13,  // 48: leapbrush.EraseRegionRequest.path:type_name -> leapbrush.PoseProto
+	11,  // 49: leapbrush.EraseRegionRequest.box_half_extents:type_name -> leapbrush.Vector3Proto
+	59,  // 50: leapbrush.AdminClearContentRequest.filter:type_name -> leapbrush.ClearContentRequest
+	27,  // 51: leapbrush.SetRoomSettingsRequest.settings:type_name -> leapbrush.RoomSettingsProto
+	29,  // 52: leapbrush.QueryCommentsResponse.comments:type_name -> leapbrush.CommentProto
+	104, // 53: leapbrush.QueryUsersResponse.results:type_name -> leapbrush.QueryUsersResponse.Result
+	18,  // 54: leapbrush.ServerStateResponse.user_state:type_name -> leapbrush.UserStateProto
+	34,  // 55: leapbrush.ServerStateResponse.brush_stroke_add:type_name -> leapbrush.BrushStrokeAddRequest
+	35,  // 56: leapbrush.ServerStateResponse.brush_stroke_remove:type_name -> leapbrush.BrushStrokeRemoveRequest
+	37,  // 57: leapbrush.ServerStateResponse.external_model_add:type_name -> leapbrush.ExternalModelAddRequest
+	38,  // 58: leapbrush.ServerStateResponse.external_model_remove:type_name -> leapbrush.ExternalModelRemoveRequest
+	66,  // 59: leapbrush.ServerStateResponse.server_info:type_name -> leapbrush.ServerInfoProto
+	36,  // 60: leapbrush.ServerStateResponse.brush_stroke_transform:type_name -> leapbrush.BrushStrokeTransformRequest
+	49,  // 61: leapbrush.ServerStateResponse.content_group_add:type_name -> leapbrush.ContentGroupAddRequest
+	51,  // 62: leapbrush.ServerStateResponse.content_group_remove:type_name -> leapbrush.ContentGroupRemoveRequest
+	32,  // 63: leapbrush.ServerStateResponse.layer_list:type_name -> leapbrush.LayerListProto
+	39,  // 64: leapbrush.ServerStateResponse.text_note_add:type_name -> leapbrush.TextNoteAddRequest
+	40,  // 65: leapbrush.ServerStateResponse.text_note_remove:type_name -> leapbrush.TextNoteRemoveRequest
+	47,  // 66: leapbrush.ServerStateResponse.comment_add:type_name -> leapbrush.CommentAddRequest
+	48,  // 67: leapbrush.ServerStateResponse.comment_remove:type_name -> leapbrush.CommentRemoveRequest
+	41,  // 68: leapbrush.ServerStateResponse.primitive_add:type_name -> leapbrush.PrimitiveAddRequest
+	42,  // 69: leapbrush.ServerStateResponse.primitive_remove:type_name -> leapbrush.PrimitiveRemoveRequest
+	27,  // 70: leapbrush.ServerStateResponse.room_settings:type_name -> leapbrush.RoomSettingsProto
+	43,  // 71: leapbrush.ServerStateResponse.measurement_add:type_name -> leapbrush.MeasurementAddRequest
+	44,  // 72: leapbrush.ServerStateResponse.measurement_remove:type_name -> leapbrush.MeasurementRemoveRequest
+	45,  // 73: leapbrush.ServerStateResponse.grab_lock_add:type_name -> leapbrush.GrabLockProto
+	46,  // 74: leapbrush.ServerStateResponse.grab_lock_remove:type_name -> leapbrush.GrabLockRequest
+	68,  // 75: leapbrush.ServerStateResponse.shutdown_notice:type_name -> leapbrush.ShutdownNoticeProto
+	67,  // 76: leapbrush.ServerStateResponse.broadcast_message:type_name -> leapbrush.BroadcastMessageProto
+	18,  // 77: leapbrush.UpdateDeviceRequest.user_state:type_name -> leapbrush.UserStateProto
+	20,  // 78: leapbrush.UpdateDeviceRequest.space_info:type_name -> leapbrush.SpaceInfoProto
+	34,  // 79: leapbrush.UpdateDeviceRequest.brush_stroke_add:type_name -> leapbrush.BrushStrokeAddRequest
+	35,  // 80: leapbrush.UpdateDeviceRequest.brush_stroke_remove:type_name -> leapbrush.BrushStrokeRemoveRequest
+	37,  // 81: leapbrush.UpdateDeviceRequest.external_model_add:type_name -> leapbrush.ExternalModelAddRequest
+	38,  // 82: leapbrush.UpdateDeviceRequest.external_model_remove:type_name -> leapbrush.ExternalModelRemoveRequest
+	36,  // 83: leapbrush.UpdateDeviceRequest.brush_stroke_transform:type_name -> leapbrush.BrushStrokeTransformRequest
+	49,  // 84: leapbrush.UpdateDeviceRequest.content_group_add:type_name -> leapbrush.ContentGroupAddRequest
+	50,  // 85: leapbrush.UpdateDeviceRequest.content_group_update:type_name -> leapbrush.ContentGroupUpdateRequest
+	51,  // 86: leapbrush.UpdateDeviceRequest.content_group_remove:type_name -> leapbrush.ContentGroupRemoveRequest
+	39,  // 87: leapbrush.UpdateDeviceRequest.text_note_add:type_name -> leapbrush.TextNoteAddRequest
+	40,  // 88: leapbrush.UpdateDeviceRequest.text_note_remove:type_name -> leapbrush.TextNoteRemoveRequest
+	47,  // 89: leapbrush.UpdateDeviceRequest.comment_add:type_name -> leapbrush.CommentAddRequest
+	48,  // 90: leapbrush.UpdateDeviceRequest.comment_remove:type_name -> leapbrush.CommentRemoveRequest
+	41,  // 91: leapbrush.UpdateDeviceRequest.primitive_add:type_name -> leapbrush.PrimitiveAddRequest
+	42,  // 92: leapbrush.UpdateDeviceRequest.primitive_remove:type_name -> leapbrush.PrimitiveRemoveRequest
+	43,  // 93: leapbrush.UpdateDeviceRequest.measurement_add:type_name -> leapbrush.MeasurementAddRequest
+	44,  // 94: leapbrush.UpdateDeviceRequest.measurement_remove:type_name -> leapbrush.MeasurementRemoveRequest
+	46,  // 95: leapbrush.UpdateDeviceRequest.grab_lock_acquire:type_name -> leapbrush.GrabLockRequest
+	46,  // 96: leapbrush.UpdateDeviceRequest.grab_lock_release:type_name -> leapbrush.GrabLockRequest
+	72,  // 97: leapbrush.UploadModelRequest.info:type_name -> leapbrush.ModelUploadInfoProto
+	11,  // 98: leapbrush.ModelCatalogEntryProto.bounds_min:type_name -> leapbrush.Vector3Proto
+	11,  // 99: leapbrush.ModelCatalogEntryProto.bounds_max:type_name -> leapbrush.Vector3Proto
+	9,   // 100: leapbrush.ModelCatalogEntryProto.source:type_name -> leapbrush.ModelCatalogEntryProto.Source
+	75,  // 101: leapbrush.QueryModelCatalogResponse.models:type_name -> leapbrush.ModelCatalogEntryProto
+	56,  // 102: leapbrush.RpcRequest.query_users_request:type_name -> leapbrush.QueryUsersRequest
+	57,  // 103: leapbrush.RpcRequest.erase_region_request:type_name -> leapbrush.EraseRegionRequest
+	59,  // 104: leapbrush.RpcRequest.clear_content_request:type_name -> leapbrush.ClearContentRequest
+	60,  // 105: leapbrush.RpcRequest.admin_clear_content_request:type_name -> leapbrush.AdminClearContentRequest
+	52,  // 106: leapbrush.RpcRequest.set_layer_request:type_name -> leapbrush.SetLayerRequest
+	53,  // 107: leapbrush.RpcRequest.remove_layer_request:type_name -> leapbrush.RemoveLayerRequest
+	54,  // 108: leapbrush.RpcRequest.set_layer_visibility_request:type_name -> leapbrush.SetLayerVisibilityRequest
+	55,  // 109: leapbrush.RpcRequest.set_content_layer_request:type_name -> leapbrush.SetContentLayerRequest
+	63,  // 110: leapbrush.RpcRequest.query_comments_request:type_name -> leapbrush.QueryCommentsRequest
+	62,  // 111: leapbrush.RpcRequest.set_room_settings_request:type_name -> leapbrush.SetRoomSettingsRequest
+	76,  // 112: leapbrush.RpcRequest.query_model_catalog_request:type_name -> leapbrush.QueryModelCatalogRequest
+	65,  // 113: leapbrush.RpcResponse.query_users_response:type_name -> leapbrush.QueryUsersResponse
+	58,  // 114: leapbrush.RpcResponse.erase_region_response:type_name -> leapbrush.EraseRegionResponse
+	61,  // 115: leapbrush.RpcResponse.clear_content_response:type_name -> leapbrush.ClearContentResponse
+	64,  // 116: leapbrush.RpcResponse.query_comments_response:type_name -> leapbrush.QueryCommentsResponse
+	77,  // 117: leapbrush.RpcResponse.query_model_catalog_response:type_name -> leapbrush.QueryModelCatalogResponse
+	105, // 118: leapbrush.ListConnectionsResponse.connections:type_name -> leapbrush.ListConnectionsResponse.Connection
+	106, // 119: leapbrush.ListConnectionsResponse.banned_users:type_name -> leapbrush.ListConnectionsResponse.BannedUser
+	107, // 120: leapbrush.ListAnchorsResponse.anchors:type_name -> leapbrush.ListAnchorsResponse.Anchor
+	21,  // 121: leapbrush.AnchorContentProto.brush_strokes:type_name -> leapbrush.BrushStrokeProto
+	22,  // 122: leapbrush.AnchorContentProto.external_models:type_name -> leapbrush.ExternalModelProto
+	24,  // 123: leapbrush.AnchorContentProto.text_notes:type_name -> leapbrush.TextNoteProto
+	26,  // 124: leapbrush.AnchorContentProto.primitives:type_name -> leapbrush.PrimitiveProto
+	28,  // 125: leapbrush.AnchorContentProto.measurements:type_name -> leapbrush.MeasurementProto
+	30,  // 126: leapbrush.AnchorContentProto.content_groups:type_name -> leapbrush.ContentGroupProto
+	29,  // 127: leapbrush.AnchorContentProto.comments:type_name -> leapbrush.CommentProto
+	45,  // 128: leapbrush.AnchorContentProto.grab_locks:type_name -> leapbrush.GrabLockProto
+	91,  // 129: leapbrush.ServerSnapshotProto.anchors:type_name -> leapbrush.AnchorContentProto
+	31,  // 130: leapbrush.ServerSnapshotProto.layers:type_name -> leapbrush.LayerProto
+	27,  // 131: leapbrush.ServerSnapshotProto.room_settings:type_name -> leapbrush.RoomSettingsProto
+	91,  // 132: leapbrush.ImportRequest.anchors:type_name -> leapbrush.AnchorContentProto
+	31,  // 133: leapbrush.ImportRequest.layers:type_name -> leapbrush.LayerProto
+	27,  // 134: leapbrush.ImportRequest.room_settings:type_name -> leapbrush.RoomSettingsProto
+	10,  // 135: leapbrush.ServerEventProto.type:type_name -> leapbrush.ServerEventProto.Type
+	102, // 136: leapbrush.WebhookRequestProto.events:type_name -> leapbrush.ServerEventProto
+	20,  // 137: leapbrush.QueryUsersResponse.Result.space_info:type_name -> leapbrush.SpaceInfoProto
+	2,   // 138: leapbrush.QueryUsersResponse.Result.device_type:type_name -> leapbrush.UserStateProto.DeviceType
+	2,   // 139: leapbrush.ListConnectionsResponse.Connection.device_type:type_name -> leapbrush.UserStateProto.DeviceType
+	15,  // 140: leapbrush.ListConnectionsResponse.Connection.headset_battery:type_name -> leapbrush.BatteryStatusProto
+	33,  // 141: leapbrush.LeapBrushApi.RegisterAndListen:input_type -> leapbrush.RegisterDeviceRequest
+	70,  // 142: leapbrush.LeapBrushApi.UpdateDeviceStream:input_type -> leapbrush.UpdateDeviceRequest
+	80,  // 143: leapbrush.LeapBrushApi.Rpc:input_type -> leapbrush.RpcRequest
+	73,  // 144: leapbrush.LeapBrushApi.UploadModel:input_type -> leapbrush.UploadModelRequest
+	78,  // 145: leapbrush.LeapBrushApi.DownloadModel:input_type -> leapbrush.DownloadModelRequest
+	82,  // 146: leapbrush.LeapBrushAdmin.ListConnections:input_type -> leapbrush.ListConnectionsRequest
+	84,  // 147: leapbrush.LeapBrushAdmin.KickUser:input_type -> leapbrush.KickUserRequest
+	86,  // 148: leapbrush.LeapBrushAdmin.UnbanUser:input_type -> leapbrush.UnbanUserRequest
+	88,  // 149: leapbrush.LeapBrushAdmin.ListAnchors:input_type -> leapbrush.ListAnchorsRequest
+	90,  // 150: leapbrush.LeapBrushAdmin.DumpAnchor:input_type -> leapbrush.DumpAnchorRequest
+	92,  // 151: leapbrush.LeapBrushAdmin.ClearAnchors:input_type -> leapbrush.ClearAnchorsRequest
+	93,  // 152: leapbrush.LeapBrushAdmin.Broadcast:input_type -> leapbrush.BroadcastRequest
+	95,  // 153: leapbrush.LeapBrushAdmin.SetVerbose:input_type -> leapbrush.SetVerboseRequest
+	97,  // 154: leapbrush.LeapBrushAdmin.Snapshot:input_type -> leapbrush.SnapshotRequest
+	99,  // 155: leapbrush.LeapBrushAdmin.Import:input_type -> leapbrush.ImportRequest
+	101, // 156: leapbrush.LeapBrushAdmin.TailEvents:input_type -> leapbrush.TailEventsRequest
+	69,  // 157: leapbrush.LeapBrushApi.RegisterAndListen:output_type -> leapbrush.ServerStateResponse
+	71,  // 158: leapbrush.LeapBrushApi.UpdateDeviceStream:output_type -> leapbrush.UpdateDeviceResponse
+	81,  // 159: leapbrush.LeapBrushApi.Rpc:output_type -> leapbrush.RpcResponse
+	74,  // 160: leapbrush.LeapBrushApi.UploadModel:output_type -> leapbrush.UploadModelResponse
+	79,  // 161: leapbrush.LeapBrushApi.DownloadModel:output_type -> leapbrush.DownloadModelResponse
+	83,  // 162: leapbrush.LeapBrushAdmin.ListConnections:output_type -> leapbrush.ListConnectionsResponse
+	85,  // 163: leapbrush.LeapBrushAdmin.KickUser:output_type -> leapbrush.KickUserResponse
+	87,  // 164: leapbrush.LeapBrushAdmin.UnbanUser:output_type -> leapbrush.UnbanUserResponse
+	89,  // 165: leapbrush.LeapBrushAdmin.ListAnchors:output_type -> leapbrush.ListAnchorsResponse
+	91,  // 166: leapbrush.LeapBrushAdmin.DumpAnchor:output_type -> leapbrush.AnchorContentProto
+	61,  // 167: leapbrush.LeapBrushAdmin.ClearAnchors:output_type -> leapbrush.ClearContentResponse
+	94,  // 168: leapbrush.LeapBrushAdmin.Broadcast:output_type -> leapbrush.BroadcastResponse
+	96,  // 169: leapbrush.LeapBrushAdmin.SetVerbose:output_type -> leapbrush.SetVerboseResponse
+	98,  // 170: leapbrush.LeapBrushAdmin.Snapshot:output_type -> leapbrush.ServerSnapshotProto
+	100, // 171: leapbrush.LeapBrushAdmin.Import:output_type -> leapbrush.ImportResponse
+	102, // 172: leapbrush.LeapBrushAdmin.TailEvents:output_type -> leapbrush.ServerEventProto
+	157, // [157:173] is the sub-list for method output_type
+	141, // [141:157] is the sub-list for method input_type
+	141, // [141:141] is the sub-list for extension type_name
+	141, // [141:141] is the sub-list for extension extendee
+	0,   // [0:141] is the sub-list for field type_name
+}
+
+func init() { file_leap_brush_api_proto_init() }
+func file_leap_brush_api_proto_init() {
+	if File_leap_brush_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_leap_brush_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vector3Proto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuaternionProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatteryStatusProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControllerStateProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandStateProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserStateProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnchorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpaceInfoProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrushStrokeProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalModelProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelAnimationProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextNoteProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrimitiveStyleProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrimitiveProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomSettingsProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeasurementProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentGroupProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayerProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayerListProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrushStrokeAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrushStrokeRemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrushStrokeTransformRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalModelAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalModelRemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextNoteAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextNoteRemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrimitiveAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrimitiveRemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeasurementAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeasurementRemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrabLockProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrabLockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentRemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentGroupAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentGroupUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentGroupRemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLayerVisibilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContentLayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseRegionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseRegionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearContentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminClearContentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearContentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRoomSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2508,8 +10460,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuaternionProto); i {
+		file_leap_brush_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCommentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2520,8 +10472,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoseProto); i {
+		file_leap_brush_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCommentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2532,8 +10484,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransformProto); i {
+		file_leap_brush_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2544,8 +10496,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatteryStatusProto); i {
+		file_leap_brush_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfoProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2556,8 +10508,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControllerStateProto); i {
+		file_leap_brush_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastMessageProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2568,8 +10520,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandStateProto); i {
+		file_leap_brush_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShutdownNoticeProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2580,8 +10532,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserStateProto); i {
+		file_leap_brush_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2592,8 +10544,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnchorProto); i {
+		file_leap_brush_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDeviceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2604,8 +10556,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpaceInfoProto); i {
+		file_leap_brush_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDeviceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2616,8 +10568,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BrushStrokeProto); i {
+		file_leap_brush_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelUploadInfoProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2628,8 +10580,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalModelProto); i {
+		file_leap_brush_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadModelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2640,8 +10592,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterDeviceRequest); i {
+		file_leap_brush_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadModelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2652,8 +10604,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BrushStrokeAddRequest); i {
+		file_leap_brush_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelCatalogEntryProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2664,8 +10616,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BrushStrokeRemoveRequest); i {
+		file_leap_brush_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryModelCatalogRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2676,8 +10628,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalModelAddRequest); i {
+		file_leap_brush_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryModelCatalogResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2688,8 +10640,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalModelRemoveRequest); i {
+		file_leap_brush_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadModelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2700,8 +10652,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryUsersRequest); i {
+		file_leap_brush_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadModelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2712,8 +10664,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryUsersResponse); i {
+		file_leap_brush_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2724,8 +10676,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerInfoProto); i {
+		file_leap_brush_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2736,8 +10688,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerStateResponse); i {
+		file_leap_brush_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConnectionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2748,8 +10700,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDeviceRequest); i {
+		file_leap_brush_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConnectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2760,8 +10712,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDeviceResponse); i {
+		file_leap_brush_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2772,8 +10724,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RpcRequest); i {
+		file_leap_brush_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2784,8 +10736,8 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RpcResponse); i {
+		file_leap_brush_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbanUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2796,7 +10748,211 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
-		file_leap_brush_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_leap_brush_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbanUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnchorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnchorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpAnchorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnchorContentProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearAnchorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVerboseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVerboseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSnapshotProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TailEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerEventProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryUsersResponse_Result); i {
 			case 0:
 				return &v.state
@@ -2808,18 +10964,61 @@ func file_leap_brush_api_proto_init() {
 				return nil
 			}
 		}
+		file_leap_brush_api_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConnectionsResponse_Connection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConnectionsResponse_BannedUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_leap_brush_api_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnchorsResponse_Anchor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_leap_brush_api_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_leap_brush_api_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_leap_brush_api_proto_msgTypes[20].OneofWrappers = []interface{}{}
 	file_leap_brush_api_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_leap_brush_api_proto_msgTypes[39].OneofWrappers = []interface{}{}
+	file_leap_brush_api_proto_msgTypes[43].OneofWrappers = []interface{}{}
+	file_leap_brush_api_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_leap_brush_api_proto_msgTypes[93].OneofWrappers = []interface{}{}
+	file_leap_brush_api_proto_msgTypes[94].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_leap_brush_api_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   26,
+			NumEnums:      11,
+			NumMessages:   97,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_leap_brush_api_proto_goTypes,
 		DependencyIndexes: file_leap_brush_api_proto_depIdxs,
@@ -30,6 +30,11 @@ type LeapBrushApiClient interface {
 	UpdateDeviceStream(ctx context.Context, opts ...grpc.CallOption) (LeapBrushApi_UpdateDeviceStreamClient, error)
 	// Generic rpc request from the client.
 	Rpc(ctx context.Context, in *RpcRequest, opts ...grpc.CallOption) (*RpcResponse, error)
+	// Rpc to upload a 3D model file to the server's asset store. The first message must contain the upload info, and
+	// all messages may contain file data.
+	UploadModel(ctx context.Context, opts ...grpc.CallOption) (LeapBrushApi_UploadModelClient, error)
+	// Rpc to download a 3D model file from the server's asset store as a stream of file data.
+	DownloadModel(ctx context.Context, in *DownloadModelRequest, opts ...grpc.CallOption) (LeapBrushApi_DownloadModelClient, error)
 }
 
 type leapBrushApiClient struct {
@@ -115,6 +120,72 @@ func (c *leapBrushApiClient) Rpc(ctx context.Context, in *RpcRequest, opts ...gr
 	return out, nil
 }
 
+func (c *leapBrushApiClient) UploadModel(ctx context.Context, opts ...grpc.CallOption) (LeapBrushApi_UploadModelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LeapBrushApi_ServiceDesc.Streams[2], "/leapbrush.LeapBrushApi/UploadModel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &leapBrushApiUploadModelClient{stream}
+	return x, nil
+}
+
+type LeapBrushApi_UploadModelClient interface {
+	Send(*UploadModelRequest) error
+	CloseAndRecv() (*UploadModelResponse, error)
+	grpc.ClientStream
+}
+
+type leapBrushApiUploadModelClient struct {
+	grpc.ClientStream
+}
+
+func (x *leapBrushApiUploadModelClient) Send(m *UploadModelRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *leapBrushApiUploadModelClient) CloseAndRecv() (*UploadModelResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadModelResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *leapBrushApiClient) DownloadModel(ctx context.Context, in *DownloadModelRequest, opts ...grpc.CallOption) (LeapBrushApi_DownloadModelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LeapBrushApi_ServiceDesc.Streams[3], "/leapbrush.LeapBrushApi/DownloadModel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &leapBrushApiDownloadModelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LeapBrushApi_DownloadModelClient interface {
+	Recv() (*DownloadModelResponse, error)
+	grpc.ClientStream
+}
+
+type leapBrushApiDownloadModelClient struct {
+	grpc.ClientStream
+}
+
+func (x *leapBrushApiDownloadModelClient) Recv() (*DownloadModelResponse, error) {
+	m := new(DownloadModelResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LeapBrushApiServer is the server API for LeapBrushApi service.
 // All implementations must embed UnimplementedLeapBrushApiServer
 // for forward compatibility
@@ -127,6 +198,11 @@ type LeapBrushApiServer interface {
 	UpdateDeviceStream(LeapBrushApi_UpdateDeviceStreamServer) error
 	// Generic rpc request from the client.
 	Rpc(context.Context, *RpcRequest) (*RpcResponse, error)
+	// Rpc to upload a 3D model file to the server's asset store. The first message must contain the upload info, and
+	// all messages may contain file data.
+	UploadModel(LeapBrushApi_UploadModelServer) error
+	// Rpc to download a 3D model file from the server's asset store as a stream of file data.
+	DownloadModel(*DownloadModelRequest, LeapBrushApi_DownloadModelServer) error
 	mustEmbedUnimplementedLeapBrushApiServer()
 }
 
@@ -143,6 +219,12 @@ func (UnimplementedLeapBrushApiServer) UpdateDeviceStream(LeapBrushApi_UpdateDev
 func (UnimplementedLeapBrushApiServer) Rpc(context.Context, *RpcRequest) (*RpcResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rpc not implemented")
 }
+func (UnimplementedLeapBrushApiServer) UploadModel(LeapBrushApi_UploadModelServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadModel not implemented")
+}
+func (UnimplementedLeapBrushApiServer) DownloadModel(*DownloadModelRequest, LeapBrushApi_DownloadModelServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadModel not implemented")
+}
 func (UnimplementedLeapBrushApiServer) mustEmbedUnimplementedLeapBrushApiServer() {}
 
 // UnsafeLeapBrushApiServer may be embedded to opt out of forward compatibility for this service.
@@ -221,6 +303,53 @@ func _LeapBrushApi_Rpc_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeapBrushApi_UploadModel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LeapBrushApiServer).UploadModel(&leapBrushApiUploadModelServer{stream})
+}
+
+type LeapBrushApi_UploadModelServer interface {
+	SendAndClose(*UploadModelResponse) error
+	Recv() (*UploadModelRequest, error)
+	grpc.ServerStream
+}
+
+type leapBrushApiUploadModelServer struct {
+	grpc.ServerStream
+}
+
+func (x *leapBrushApiUploadModelServer) SendAndClose(m *UploadModelResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *leapBrushApiUploadModelServer) Recv() (*UploadModelRequest, error) {
+	m := new(UploadModelRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LeapBrushApi_DownloadModel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadModelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LeapBrushApiServer).DownloadModel(m, &leapBrushApiDownloadModelServer{stream})
+}
+
+type LeapBrushApi_DownloadModelServer interface {
+	Send(*DownloadModelResponse) error
+	grpc.ServerStream
+}
+
+type leapBrushApiDownloadModelServer struct {
+	grpc.ServerStream
+}
+
+func (x *leapBrushApiDownloadModelServer) Send(m *DownloadModelResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // LeapBrushApi_ServiceDesc is the grpc.ServiceDesc for LeapBrushApi service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -244,6 +373,512 @@ var LeapBrushApi_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _LeapBrushApi_UpdateDeviceStream_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UploadModel",
+			Handler:       _LeapBrushApi_UploadModel_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadModel",
+			Handler:       _LeapBrushApi_DownloadModel_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "leap_brush_api.proto",
+}
+
+// LeapBrushAdminClient is the client API for LeapBrushAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeapBrushAdminClient interface {
+	// Rpc to list connected users with their app versions and lag.
+	ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error)
+	// Rpc to disconnect a user, optionally banning them from connecting again.
+	KickUser(ctx context.Context, in *KickUserRequest, opts ...grpc.CallOption) (*KickUserResponse, error)
+	// Rpc to allow a banned user to connect again.
+	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*UnbanUserResponse, error)
+	// Rpc to list anchors with a summary of the content attached to each.
+	ListAnchors(ctx context.Context, in *ListAnchorsRequest, opts ...grpc.CallOption) (*ListAnchorsResponse, error)
+	// Rpc to dump all content attached to an anchor.
+	DumpAnchor(ctx context.Context, in *DumpAnchorRequest, opts ...grpc.CallOption) (*AnchorContentProto, error)
+	// Rpc to remove all content attached to anchors, including content in locked layers.
+	ClearAnchors(ctx context.Context, in *ClearAnchorsRequest, opts ...grpc.CallOption) (*ClearContentResponse, error)
+	// Rpc to send a message to all connected users.
+	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error)
+	// Rpc to enable or disable verbose logging until the config is next reloaded.
+	SetVerbose(ctx context.Context, in *SetVerboseRequest, opts ...grpc.CallOption) (*SetVerboseResponse, error)
+	// Rpc to dump all anchors and layers at a single point in time.
+	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*ServerSnapshotProto, error)
+	// Rpc to add content and layers from a dump or snapshot.
+	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	// Rpc to follow a stream of user and content events as they happen.
+	TailEvents(ctx context.Context, in *TailEventsRequest, opts ...grpc.CallOption) (LeapBrushAdmin_TailEventsClient, error)
+}
+
+type leapBrushAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeapBrushAdminClient(cc grpc.ClientConnInterface) LeapBrushAdminClient {
+	return &leapBrushAdminClient{cc}
+}
+
+func (c *leapBrushAdminClient) ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error) {
+	out := new(ListConnectionsResponse)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/ListConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) KickUser(ctx context.Context, in *KickUserRequest, opts ...grpc.CallOption) (*KickUserResponse, error) {
+	out := new(KickUserResponse)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/KickUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*UnbanUserResponse, error) {
+	out := new(UnbanUserResponse)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/UnbanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) ListAnchors(ctx context.Context, in *ListAnchorsRequest, opts ...grpc.CallOption) (*ListAnchorsResponse, error) {
+	out := new(ListAnchorsResponse)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/ListAnchors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) DumpAnchor(ctx context.Context, in *DumpAnchorRequest, opts ...grpc.CallOption) (*AnchorContentProto, error) {
+	out := new(AnchorContentProto)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/DumpAnchor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) ClearAnchors(ctx context.Context, in *ClearAnchorsRequest, opts ...grpc.CallOption) (*ClearContentResponse, error) {
+	out := new(ClearContentResponse)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/ClearAnchors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error) {
+	out := new(BroadcastResponse)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/Broadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) SetVerbose(ctx context.Context, in *SetVerboseRequest, opts ...grpc.CallOption) (*SetVerboseResponse, error) {
+	out := new(SetVerboseResponse)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/SetVerbose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*ServerSnapshotProto, error) {
+	out := new(ServerSnapshotProto)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/Snapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, "/leapbrush.LeapBrushAdmin/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leapBrushAdminClient) TailEvents(ctx context.Context, in *TailEventsRequest, opts ...grpc.CallOption) (LeapBrushAdmin_TailEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LeapBrushAdmin_ServiceDesc.Streams[0], "/leapbrush.LeapBrushAdmin/TailEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &leapBrushAdminTailEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LeapBrushAdmin_TailEventsClient interface {
+	Recv() (*ServerEventProto, error)
+	grpc.ClientStream
+}
+
+type leapBrushAdminTailEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *leapBrushAdminTailEventsClient) Recv() (*ServerEventProto, error) {
+	m := new(ServerEventProto)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LeapBrushAdminServer is the server API for LeapBrushAdmin service.
+// All implementations must embed UnimplementedLeapBrushAdminServer
+// for forward compatibility
+type LeapBrushAdminServer interface {
+	// Rpc to list connected users with their app versions and lag.
+	ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error)
+	// Rpc to disconnect a user, optionally banning them from connecting again.
+	KickUser(context.Context, *KickUserRequest) (*KickUserResponse, error)
+	// Rpc to allow a banned user to connect again.
+	UnbanUser(context.Context, *UnbanUserRequest) (*UnbanUserResponse, error)
+	// Rpc to list anchors with a summary of the content attached to each.
+	ListAnchors(context.Context, *ListAnchorsRequest) (*ListAnchorsResponse, error)
+	// Rpc to dump all content attached to an anchor.
+	DumpAnchor(context.Context, *DumpAnchorRequest) (*AnchorContentProto, error)
+	// Rpc to remove all content attached to anchors, including content in locked layers.
+	ClearAnchors(context.Context, *ClearAnchorsRequest) (*ClearContentResponse, error)
+	// Rpc to send a message to all connected users.
+	Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error)
+	// Rpc to enable or disable verbose logging until the config is next reloaded.
+	SetVerbose(context.Context, *SetVerboseRequest) (*SetVerboseResponse, error)
+	// Rpc to dump all anchors and layers at a single point in time.
+	Snapshot(context.Context, *SnapshotRequest) (*ServerSnapshotProto, error)
+	// Rpc to add content and layers from a dump or snapshot.
+	Import(context.Context, *ImportRequest) (*ImportResponse, error)
+	// Rpc to follow a stream of user and content events as they happen.
+	TailEvents(*TailEventsRequest, LeapBrushAdmin_TailEventsServer) error
+	mustEmbedUnimplementedLeapBrushAdminServer()
+}
+
+// UnimplementedLeapBrushAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedLeapBrushAdminServer struct {
+}
+
+func (UnimplementedLeapBrushAdminServer) ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) KickUser(context.Context, *KickUserRequest) (*KickUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickUser not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) UnbanUser(context.Context, *UnbanUserRequest) (*UnbanUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) ListAnchors(context.Context, *ListAnchorsRequest) (*ListAnchorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnchors not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) DumpAnchor(context.Context, *DumpAnchorRequest) (*AnchorContentProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpAnchor not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) ClearAnchors(context.Context, *ClearAnchorsRequest) (*ClearContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAnchors not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) SetVerbose(context.Context, *SetVerboseRequest) (*SetVerboseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVerbose not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) Snapshot(context.Context, *SnapshotRequest) (*ServerSnapshotProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) Import(context.Context, *ImportRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) TailEvents(*TailEventsRequest, LeapBrushAdmin_TailEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailEvents not implemented")
+}
+func (UnimplementedLeapBrushAdminServer) mustEmbedUnimplementedLeapBrushAdminServer() {}
+
+// UnsafeLeapBrushAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeapBrushAdminServer will
+// result in compilation errors.
+type UnsafeLeapBrushAdminServer interface {
+	mustEmbedUnimplementedLeapBrushAdminServer()
+}
+
+func RegisterLeapBrushAdminServer(s grpc.ServiceRegistrar, srv LeapBrushAdminServer) {
+	s.RegisterService(&LeapBrushAdmin_ServiceDesc, srv)
+}
+
+func _LeapBrushAdmin_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/ListConnections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).ListConnections(ctx, req.(*ListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_KickUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).KickUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/KickUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).KickUser(ctx, req.(*KickUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/UnbanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).UnbanUser(ctx, req.(*UnbanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_ListAnchors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnchorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).ListAnchors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/ListAnchors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).ListAnchors(ctx, req.(*ListAnchorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_DumpAnchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpAnchorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).DumpAnchor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/DumpAnchor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).DumpAnchor(ctx, req.(*DumpAnchorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_ClearAnchors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearAnchorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).ClearAnchors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/ClearAnchors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).ClearAnchors(ctx, req.(*ClearAnchorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).Broadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/Broadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).Broadcast(ctx, req.(*BroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_SetVerbose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVerboseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).SetVerbose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/SetVerbose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).SetVerbose(ctx, req.(*SetVerboseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).Snapshot(ctx, req.(*SnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeapBrushAdminServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leapbrush.LeapBrushAdmin/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeapBrushAdminServer).Import(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeapBrushAdmin_TailEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LeapBrushAdminServer).TailEvents(m, &leapBrushAdminTailEventsServer{stream})
+}
+
+type LeapBrushAdmin_TailEventsServer interface {
+	Send(*ServerEventProto) error
+	grpc.ServerStream
+}
+
+type leapBrushAdminTailEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *leapBrushAdminTailEventsServer) Send(m *ServerEventProto) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// LeapBrushAdmin_ServiceDesc is the grpc.ServiceDesc for LeapBrushAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeapBrushAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "leapbrush.LeapBrushAdmin",
+	HandlerType: (*LeapBrushAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListConnections",
+			Handler:    _LeapBrushAdmin_ListConnections_Handler,
+		},
+		{
+			MethodName: "KickUser",
+			Handler:    _LeapBrushAdmin_KickUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _LeapBrushAdmin_UnbanUser_Handler,
+		},
+		{
+			MethodName: "ListAnchors",
+			Handler:    _LeapBrushAdmin_ListAnchors_Handler,
+		},
+		{
+			MethodName: "DumpAnchor",
+			Handler:    _LeapBrushAdmin_DumpAnchor_Handler,
+		},
+		{
+			MethodName: "ClearAnchors",
+			Handler:    _LeapBrushAdmin_ClearAnchors_Handler,
+		},
+		{
+			MethodName: "Broadcast",
+			Handler:    _LeapBrushAdmin_Broadcast_Handler,
+		},
+		{
+			MethodName: "SetVerbose",
+			Handler:    _LeapBrushAdmin_SetVerbose_Handler,
+		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _LeapBrushAdmin_Snapshot_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _LeapBrushAdmin_Import_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailEvents",
+			Handler:       _LeapBrushAdmin_TailEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "leap_brush_api.proto",
 }
@@ -0,0 +1,463 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+// printJSON prints a proto message as indented JSON.
+func printJSON(message proto.Message) error {
+	return writeJSON(os.Stdout, message)
+}
+
+// writeJSON writes a proto message as indented JSON.
+func writeJSON(w io.Writer, message proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(message)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// writeJSONFile writes a proto message as indented JSON to a file, or to stdout if path is empty or "-".
+func writeJSONFile(path string, message proto.Message) error {
+	if path == "" || path == "-" {
+		return printJSON(message)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(f, message); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// newTable returns a writer that aligns tab separated columns, which must be flushed once all rows are written.
+func newTable(headers ...string) *tabwriter.Writer {
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, strings.Join(headers, "\t"))
+	return table
+}
+
+// formatMillis formats a duration in milliseconds for display.
+func formatMillis(millis int64) string {
+	if millis < 0 {
+		return "-"
+	}
+	return (time.Duration(millis) * time.Millisecond).Round(time.Millisecond).String()
+}
+
+// formatBytes formats a size in bytes for display.
+func formatBytes(size uint64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MiB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(size)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", size)
+}
+
+// orDash returns value, or "-" if it is empty.
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func runUsers(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("users", flag.ExitOnError)
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 0); err != nil {
+		return err
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	resp, err := admin.client.ListConnections(ctx, &pb.ListConnectionsRequest{})
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(resp)
+	}
+
+	table := newTable("USER", "NAME", "DEVICE", "APP VERSION", "LISTENING", "UPDATE LAG", "PENDING", "SENT",
+		"ANCHORS", "SPACE")
+	for _, connection := range resp.Connections {
+		deviceType := "-"
+		if connection.DeviceType != nil {
+			deviceType = connection.DeviceType.String()
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%v\t%s\t%d\t%s\t%s\t%s\n", connection.UserName,
+			orDash(connection.UserDisplayName), deviceType, orDash(connection.AppVersion), connection.Listening,
+			formatMillis(connection.UpdateLagMillis), connection.PendingNotifications,
+			formatBytes(connection.SentBytes), orDash(strings.Join(connection.AnchorIds, ",")),
+			orDash(connection.SpaceName))
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	if len(resp.BannedUsers) > 0 {
+		fmt.Println()
+		table = newTable("BANNED USER", "REASON")
+		for _, bannedUser := range resp.BannedUsers {
+			fmt.Fprintf(table, "%s\t%s\n", bannedUser.UserName, orDash(bannedUser.Reason))
+		}
+		return table.Flush()
+	}
+	return nil
+}
+
+func runAnchors(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("anchors", flag.ExitOnError)
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 0); err != nil {
+		return err
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	resp, err := admin.client.ListAnchors(ctx, &pb.ListAnchorsRequest{})
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(resp)
+	}
+
+	table := newTable("ANCHOR", "USERS", "STROKES", "POSES", "MODELS", "NOTES", "PRIMITIVES", "MEASUREMENTS",
+		"GROUPS", "COMMENTS")
+	for _, anchor := range resp.Anchors {
+		fmt.Fprintf(table, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n", anchor.Id,
+			orDash(strings.Join(anchor.UserNames, ",")), anchor.BrushStrokeCount, anchor.BrushPoseCount,
+			anchor.ExternalModelCount, anchor.TextNoteCount, anchor.PrimitiveCount, anchor.MeasurementCount,
+			anchor.ContentGroupCount, anchor.CommentCount)
+	}
+	return table.Flush()
+}
+
+func runStrokes(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("strokes", flag.ExitOnError)
+	anchorId := flags.String("anchor", "", "The anchor id to list brush strokes for")
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 0); err != nil {
+		return err
+	}
+	if *anchorId == "" {
+		return errors.New("--anchor must be set")
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	content, err := admin.client.DumpAnchor(ctx, &pb.DumpAnchorRequest{AnchorId: *anchorId})
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(&pb.AnchorContentProto{AnchorId: content.AnchorId, BrushStrokes: content.BrushStrokes})
+	}
+
+	table := newTable("STROKE", "USER", "TYPE", "COLOR", "POSES", "LAYER", "PARENT")
+	for _, brushStroke := range content.BrushStrokes {
+		fmt.Fprintf(table, "%s\t%s\t%v\t#%06x\t%d\t%s\t%s\n", brushStroke.Id, orDash(brushStroke.UserName),
+			brushStroke.Type, brushStroke.StrokeColorRgb, len(brushStroke.BrushPose), orDash(brushStroke.LayerId),
+			orDash(brushStroke.ParentId))
+	}
+	return table.Flush()
+}
+
+func runKick(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("kick", flag.ExitOnError)
+	ban := flags.Bool("ban", false, "Whether to also prevent the user from connecting again until unbanned")
+	reason := flags.String("reason", "", "Optional reason shown to the user")
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 1); err != nil {
+		return err
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	resp, err := admin.client.KickUser(ctx, &pb.KickUserRequest{UserName: flags.Arg(0), Ban: *ban, Reason: *reason})
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(resp)
+	}
+
+	action := "Kicked"
+	if *ban {
+		action = "Banned"
+	}
+	if resp.WasConnected {
+		fmt.Printf("%s %s\n", action, flags.Arg(0))
+	} else {
+		fmt.Printf("%s %s (was not connected)\n", action, flags.Arg(0))
+	}
+	return nil
+}
+
+func runUnban(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("unban", flag.ExitOnError)
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 1); err != nil {
+		return err
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	resp, err := admin.client.UnbanUser(ctx, &pb.UnbanUserRequest{UserName: flags.Arg(0)})
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(resp)
+	}
+
+	if resp.WasBanned {
+		fmt.Printf("Unbanned %s\n", flags.Arg(0))
+	} else {
+		fmt.Printf("%s was not banned\n", flags.Arg(0))
+	}
+	return nil
+}
+
+func runClear(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("clear", flag.ExitOnError)
+	all := flags.Bool("all", false, "Whether to clear every anchor")
+	parseCommandFlags(flags, args)
+	if *all == (flags.NArg() > 0) {
+		return errors.New("either --all or anchor ids must be given")
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	resp, err := admin.client.ClearAnchors(ctx, &pb.ClearAnchorsRequest{AnchorIds: flags.Args(), AllAnchors: *all})
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(resp)
+	}
+
+	fmt.Printf("Removed %d brush strokes, %d models, %d text notes, %d primitives and %d measurements\n",
+		len(resp.RemovedBrushStrokeIds), len(resp.RemovedExternalModelIds), len(resp.RemovedTextNoteIds),
+		len(resp.RemovedPrimitiveIds), len(resp.RemovedMeasurementIds))
+	return nil
+}
+
+func runExport(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	anchorId := flags.String("anchor", "", "The anchor id to export")
+	output := flags.String("o", "", "The file to write, or stdout if empty")
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 0); err != nil {
+		return err
+	}
+	if *anchorId == "" {
+		return errors.New("--anchor must be set")
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	content, err := admin.client.DumpAnchor(ctx, &pb.DumpAnchorRequest{AnchorId: *anchorId})
+	if err != nil {
+		return err
+	}
+	if err := writeJSONFile(*output, content); err != nil {
+		return err
+	}
+	if *output != "" && *output != "-" {
+		fmt.Fprintf(os.Stderr, "Exported %d brush strokes and %d models from anchor %s to %s\n",
+			len(content.BrushStrokes), len(content.ExternalModels), content.AnchorId, *output)
+	}
+	return nil
+}
+
+func runImport(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	anchorId := flags.String("anchor", "",
+		"Optional anchor id to import into instead of the anchor in the file, for files with a single anchor. "+
+			"Content with ids that still exist on any anchor, such as the anchor in the file, is skipped")
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 1); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	// The file may be a snapshot or an export of a single anchor.
+	req := &pb.ImportRequest{}
+	snapshot := &pb.ServerSnapshotProto{}
+	if err := protojson.Unmarshal(data, snapshot); err == nil {
+		req.Anchors = snapshot.Anchors
+		req.Layers = snapshot.Layers
+		req.RoomSettings = snapshot.RoomSettings
+	} else {
+		content := &pb.AnchorContentProto{}
+		if err := protojson.Unmarshal(data, content); err != nil {
+			return fmt.Errorf("%s is not an export or snapshot file: %v", flags.Arg(0), err)
+		}
+		req.Anchors = []*pb.AnchorContentProto{content}
+	}
+	if *anchorId != "" {
+		if len(req.Anchors) != 1 {
+			return fmt.Errorf("--anchor requires a file with a single anchor, %s has %d", flags.Arg(0),
+				len(req.Anchors))
+		}
+		req.Anchors[0].AnchorId = *anchorId
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	resp, err := admin.client.Import(ctx, req)
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(resp)
+	}
+
+	fmt.Printf("Imported %d items and %d layers into %d anchors, skipped %d existing or invalid items\n",
+		resp.NumImported, resp.NumLayers, len(req.Anchors), resp.NumSkipped)
+	return nil
+}
+
+func runSnapshot(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	output := flags.String("o", "", "The file to write, or stdout if empty")
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 0); err != nil {
+		return err
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	snapshot, err := admin.client.Snapshot(ctx, &pb.SnapshotRequest{})
+	if err != nil {
+		return err
+	}
+	if err := writeJSONFile(*output, snapshot); err != nil {
+		return err
+	}
+	if *output != "" && *output != "-" {
+		fmt.Fprintf(os.Stderr, "Wrote snapshot of %d anchors and %d layers to %s\n",
+			len(snapshot.Anchors), len(snapshot.Layers), *output)
+	}
+	return nil
+}
+
+func runTail(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("tail", flag.ExitOnError)
+	anchorId := flags.String("anchor", "", "Optional anchor id to only follow content events for that anchor")
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 0); err != nil {
+		return err
+	}
+
+	ctx, cancel := admin.Context(0)
+	defer cancel()
+	stream, err := admin.client.TailEvents(ctx, &pb.TailEventsRequest{AnchorId: *anchorId})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if *jsonOutput {
+			// Events are printed one per line so that the output can be processed as a stream.
+			data, err := protojson.Marshal(event)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s\n", data)
+			continue
+		}
+
+		line := fmt.Sprintf("%s  %-22v %s", time.UnixMilli(event.TimeMillis).Format("15:04:05.000"), event.Type,
+			event.UserName)
+		if event.AnchorId != "" {
+			line += fmt.Sprintf("  anchor %s", event.AnchorId)
+		}
+		if event.ContentId != "" {
+			line += fmt.Sprintf("  %s", event.ContentId)
+		}
+		if event.Detail != "" {
+			line += fmt.Sprintf("  %q", event.Detail)
+		}
+		fmt.Println(line)
+	}
+}
+
+func runBroadcast(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("broadcast", flag.ExitOnError)
+	parseCommandFlags(flags, args)
+	if flags.NArg() == 0 {
+		return errors.New("a message must be given")
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	resp, err := admin.client.Broadcast(ctx, &pb.BroadcastRequest{Text: strings.Join(flags.Args(), " ")})
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(resp)
+	}
+
+	fmt.Printf("Sent message to %d users\n", resp.NumUsers)
+	return nil
+}
+
+func runVerbose(admin *adminClient, args []string) error {
+	flags := flag.NewFlagSet("verbose", flag.ExitOnError)
+	parseCommandFlags(flags, args)
+	if err := requireArgs(flags, 1); err != nil {
+		return err
+	}
+	if flags.Arg(0) != "on" && flags.Arg(0) != "off" {
+		return fmt.Errorf("expected on or off, got %q", flags.Arg(0))
+	}
+
+	ctx, cancel := admin.Context(rpcTimeout)
+	defer cancel()
+	resp, err := admin.client.SetVerbose(ctx, &pb.SetVerboseRequest{Verbose: flags.Arg(0) == "on"})
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		return printJSON(resp)
+	}
+
+	fmt.Printf("Verbose logging %s (was %v)\n", flags.Arg(0), resp.PreviousVerbose)
+	return nil
+}
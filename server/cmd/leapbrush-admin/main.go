@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Timeout for each admin rpc other than tail.
+	rpcTimeout = 30 * time.Second
+)
+
+var (
+	addr       = flag.String("addr", "localhost:8402", "The address of the server to manage")
+	useTLS     = flag.Bool("use-tls", false, "Whether to connect to the server with TLS")
+	adminToken = flag.String("admin-token", os.Getenv("LEAPBRUSH_ADMIN_TOKEN"),
+		"The admin token configured on the server. Defaults to $LEAPBRUSH_ADMIN_TOKEN")
+	jsonOutput = flag.Bool("json", false, "Whether to print results as JSON instead of tables")
+)
+
+// command is a leapbrush-admin subcommand.
+type command struct {
+	// The arguments shown in usage after the command name.
+	usage string
+	// A one line description of the command.
+	description string
+	// Runs the command with the command line arguments after the command name.
+	run func(admin *adminClient, args []string) error
+}
+
+// The subcommands by name. Initialized in init since commands refer to this map for their usage.
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"users":     {"", "List connected and banned users", runUsers},
+		"anchors":   {"", "List anchors with their content counts", runAnchors},
+		"strokes":   {"--anchor <id>", "List the brush strokes attached to an anchor", runStrokes},
+		"kick":      {"[--ban] [--reason <text>] <user>", "Disconnect a user, optionally banning them", runKick},
+		"unban":     {"<user>", "Allow a banned user to connect again", runUnban},
+		"clear":     {"--all | <anchor id>...", "Remove all content from anchors, including locked layers", runClear},
+		"export":    {"--anchor <id> [-o <file>]", "Write the content of an anchor to a JSON file", runExport},
+		"import":    {"[--anchor <id>] <file>", "Add content, layers and room settings, skipping ids in use", runImport},
+		"snapshot":  {"[-o <file>]", "Write all anchors, layers and room settings to a JSON file", runSnapshot},
+		"tail":      {"[--anchor <id>]", "Follow user and content events as they happen", runTail},
+		"broadcast": {"<message>", "Send a message to all connected users", runBroadcast},
+		"verbose":   {"on | off", "Enable or disable verbose logging on the server", runVerbose},
+	}
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: leapbrush-admin [flags] <command> [command flags]\n\nCommands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(out, "  %s %s\n    \t%s\n", name, commands[name].usage, commands[name].description)
+	}
+	fmt.Fprintf(out, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+// adminClient wraps the admin service client with the admin token.
+type adminClient struct {
+	client pb.LeapBrushAdminClient
+	token  string
+}
+
+// Context returns a context for an admin rpc that carries the admin token, and is cancelled after timeout if
+// positive or otherwise on Ctrl-C.
+func (a *adminClient) Context(timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+a.token)
+	if timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return signal.NotifyContext(ctx, os.Interrupt)
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(flag.CommandLine.Output(), "Unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+	if *adminToken == "" {
+		log.Fatalf("Set --admin-token or $LEAPBRUSH_ADMIN_TOKEN to the admin token configured on the server")
+	}
+
+	var opts []grpc.DialOption
+	if *useTLS {
+		cp, err := x509.SystemCertPool()
+		if err != nil {
+			log.Fatalf("Failed to get system cert pool: %v", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: cp})))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	conn, err := grpc.Dial(*addr, opts...)
+	if err != nil {
+		log.Fatalf("Failed to connect to %v: %v", *addr, err)
+	}
+	defer conn.Close()
+
+	admin := &adminClient{client: pb.NewLeapBrushAdminClient(conn), token: *adminToken}
+	if err := cmd.run(admin, flag.Args()[1:]); err != nil {
+		log.Fatalf("%s: %v", flag.Arg(0), err)
+	}
+}
+
+// parseCommandFlags parses the flags of a command, exiting with the command's usage on error.
+func parseCommandFlags(flags *flag.FlagSet, args []string) {
+	cmd := commands[flags.Name()]
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: leapbrush-admin %s %s\n\n%s\n", flags.Name(), cmd.usage, cmd.description)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+}
+
+// requireArgs returns an error unless a command was given exactly n positional arguments.
+func requireArgs(flags *flag.FlagSet, n int) error {
+	if flags.NArg() != n {
+		return fmt.Errorf("expected %d arguments, got %d: usage: leapbrush-admin %s %s",
+			n, flags.NArg(), flags.Name(), strings.TrimSpace(commands[flags.Name()].usage))
+	}
+	return nil
+}
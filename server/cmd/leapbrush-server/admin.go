@@ -0,0 +1,787 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+// AdminServer implements the leap brush admin api for managing a running server.
+type AdminServer struct {
+	pb.UnimplementedLeapBrushAdminServer
+
+	// The server being managed.
+	server *Server
+}
+
+// CheckAdminCredentialLocked checks that an admin call includes the server's admin token as bearer authorization
+// metadata, and returns a name identifying the caller for logging. s.lock must be held while calling this function.
+func (s *Server) CheckAdminCredentialLocked(ctx context.Context, action string) (string, error) {
+	adminName := "admin"
+	if p, ok := peer.FromContext(ctx); ok {
+		adminName = fmt.Sprintf("admin@%v", p.Addr)
+	}
+
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("authorization") {
+			if strings.HasPrefix(value, "Bearer ") {
+				token = strings.TrimPrefix(value, "Bearer ")
+			}
+		}
+	}
+	if !s.IsAdminTokenValid(token) {
+		log.Printf("User %s: *** Rejected admin %s request with invalid admin token", adminName, action)
+		return "", status.Errorf(codes.PermissionDenied, "invalid admin token")
+	}
+	return adminName, nil
+}
+
+// CheckUserAdmittedLocked returns an error if a user is banned, or was kicked after opening a stream at
+// streamStartTime. s.lock must be held while calling this function.
+func (s *Server) CheckUserAdmittedLocked(userName string, streamStartTime time.Time) error {
+	if reason, ok := s.bannedUsers[userName]; ok {
+		return KickError(reason, true)
+	}
+	if kickTime, ok := s.kickTimes[userName]; ok && !streamStartTime.After(kickTime) {
+		return KickError("", false)
+	}
+	return nil
+}
+
+// KickError returns the error sent to a client that was disconnected by an admin.
+func KickError(reason string, banned bool) error {
+	message := "disconnected by the server admin"
+	if banned {
+		message = "banned by the server admin"
+	}
+	if reason != "" {
+		message += ": " + reason
+	}
+	if banned {
+		return status.Error(codes.PermissionDenied, message)
+	}
+	return status.Error(codes.Aborted, message)
+}
+
+// KickUserLocked closes a user's listening stream and update stream and forgets their state, optionally banning them
+// from connecting again. Returns whether the user was connected. s.lock must be held while calling this function.
+func (s *Server) KickUserLocked(userName string, reason string, ban bool) bool {
+	now := time.Now()
+	wasConnected := false
+
+	s.kickTimes[userName] = now
+	if ban {
+		s.bannedUsers[userName] = reason
+	}
+
+	if userConnectionEntry, ok := s.userConnectionsMap[userName]; ok {
+		wasConnected = true
+		userConnectionEntry.kickError = KickError(reason, ban)
+		select {
+		case userConnectionEntry.shutDownStart <- true:
+		default:
+		}
+	}
+	if userState, ok := s.userStateMap[userName]; ok {
+		wasConnected = true
+		s.RemoveUserAnchorsLocked(userState)
+		delete(s.userStateMap, userName)
+		s.ExpireGrabLocksLocked(now)
+		if ban {
+			s.PublishEventLocked(pb.ServerEventProto_USER_LEFT, userName, "", "", "banned")
+		} else {
+			s.PublishEventLocked(pb.ServerEventProto_USER_LEFT, userName, "", "", "kicked")
+		}
+	}
+	return wasConnected
+}
+
+// NumPendingNotifications returns the number of content notifications waiting to be sent on this connection.
+func (u *UserConnectionState) NumPendingNotifications() int {
+	return len(u.notifyAboutBrushStrokeAdds) + len(u.notifyAboutBrushStrokeRemovals) +
+		len(u.notifyAboutBrushStrokeTransforms) + len(u.notifyAboutExternalModelAdds) +
+		len(u.notifyAboutExternalModelRemovals) + len(u.notifyAboutTextNoteAdds) + len(u.notifyAboutTextNoteRemovals) +
+		len(u.notifyAboutPrimitiveAdds) + len(u.notifyAboutPrimitiveRemovals) + len(u.notifyAboutMeasurementAdds) +
+		len(u.notifyAboutMeasurementRemovals) + len(u.notifyAboutContentGroupAdds) +
+		len(u.notifyAboutContentGroupRemovals) + len(u.notifyAboutCommentAdds) + len(u.notifyAboutCommentRemovals) +
+		len(u.notifyAboutGrabLockAdds) + len(u.notifyAboutGrabLockRemovals) + len(u.notifyAboutBroadcasts)
+}
+
+// SortContentIds sorts the ids of content attached to this anchor by the time the server first received the content.
+func (a *AnchorState) SortContentIds(ids []string) {
+	sort.Slice(ids, func(i, j int) bool {
+		var createTimeI, createTimeJ time.Time
+		if contentInfo, ok := a.contentInfo[ids[i]]; ok {
+			createTimeI = contentInfo.createTime
+		}
+		if contentInfo, ok := a.contentInfo[ids[j]]; ok {
+			createTimeJ = contentInfo.createTime
+		}
+		if !createTimeI.Equal(createTimeJ) {
+			return createTimeI.Before(createTimeJ)
+		}
+		return ids[i] < ids[j]
+	})
+}
+
+// BuildAnchorContentLocked returns all content attached to an anchor. s.lock must be held while calling this function.
+func (s *Server) BuildAnchorContentLocked(anchorState *AnchorState) *pb.AnchorContentProto {
+	content := &pb.AnchorContentProto{AnchorId: anchorState.id}
+
+	var ids []string
+	for id := range anchorState.brushStrokes {
+		ids = append(ids, id)
+	}
+	anchorState.SortContentIds(ids)
+	for _, id := range ids {
+		content.BrushStrokes = append(content.BrushStrokes, anchorState.brushStrokes[id])
+	}
+
+	ids = nil
+	for id := range anchorState.externalModels {
+		ids = append(ids, id)
+	}
+	anchorState.SortContentIds(ids)
+	for _, id := range ids {
+		content.ExternalModels = append(content.ExternalModels, anchorState.externalModels[id])
+	}
+
+	ids = nil
+	for id := range anchorState.textNotes {
+		ids = append(ids, id)
+	}
+	anchorState.SortContentIds(ids)
+	for _, id := range ids {
+		content.TextNotes = append(content.TextNotes, anchorState.textNotes[id])
+	}
+
+	ids = nil
+	for id := range anchorState.primitives {
+		ids = append(ids, id)
+	}
+	anchorState.SortContentIds(ids)
+	for _, id := range ids {
+		content.Primitives = append(content.Primitives, anchorState.primitives[id])
+	}
+
+	ids = nil
+	for id := range anchorState.measurements {
+		ids = append(ids, id)
+	}
+	anchorState.SortContentIds(ids)
+	for _, id := range ids {
+		content.Measurements = append(content.Measurements, anchorState.measurements[id])
+	}
+
+	ids = nil
+	for id := range anchorState.contentGroups {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		content.ContentGroups = append(content.ContentGroups, anchorState.contentGroups[id])
+	}
+
+	for _, comment := range anchorState.comments {
+		content.Comments = append(content.Comments, comment)
+	}
+	sort.Slice(content.Comments, func(i, j int) bool {
+		if content.Comments[i].CreateTimeMillis != content.Comments[j].CreateTimeMillis {
+			return content.Comments[i].CreateTimeMillis < content.Comments[j].CreateTimeMillis
+		}
+		return content.Comments[i].Id < content.Comments[j].Id
+	})
+
+	ids = nil
+	for id := range anchorState.grabLocks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		content.GrabLocks = append(content.GrabLocks, anchorState.grabLocks[id])
+	}
+
+	return content
+}
+
+// ListConnections handles an admin rpc to list the users that have a listening stream or have sent updates and not
+// timed out.
+func (a *AdminServer) ListConnections(ctx context.Context, _ *pb.ListConnectionsRequest) (*pb.ListConnectionsResponse, error) {
+	s := a.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, err := s.CheckAdminCredentialLocked(ctx, "list connections"); err != nil {
+		return nil, err
+	}
+
+	return s.BuildConnectionListLocked(), nil
+}
+
+// BuildConnectionListLocked returns the users that have a listening stream or have sent updates and not timed out,
+// and the banned users, ordered by user name. s.lock must be held while calling this function.
+func (s *Server) BuildConnectionListLocked() *pb.ListConnectionsResponse {
+	userNameSet := make(map[string]bool)
+	for userName := range s.userConnectionsMap {
+		userNameSet[userName] = true
+	}
+	for userName := range s.userStateMap {
+		userNameSet[userName] = true
+	}
+	userNames := make([]string, 0, len(userNameSet))
+	for userName := range userNameSet {
+		userNames = append(userNames, userName)
+	}
+	sort.Strings(userNames)
+
+	now := time.Now()
+	resp := &pb.ListConnectionsResponse{}
+	for _, userName := range userNames {
+		connection := &pb.ListConnectionsResponse_Connection{UserName: userName, UpdateLagMillis: -1}
+		if userConnectionEntry, ok := s.userConnectionsMap[userName]; ok {
+			connection.AppVersion = userConnectionEntry.appVersion
+			connection.Listening = true
+			connection.ConnectTimeMillis = userConnectionEntry.connectTime.UnixMilli()
+			connection.PendingNotifications = int32(userConnectionEntry.NumPendingNotifications())
+			connection.SentBytes = atomic.LoadUint64(&userConnectionEntry.bytesSent)
+		}
+		if userState, ok := s.userStateMap[userName]; ok {
+			connection.UserDisplayName = userState.stateProto.UserDisplayName
+			connection.DeviceType = userState.stateProto.DeviceType
+			connection.HeadsetBattery = userState.stateProto.HeadsetBattery
+			connection.UpdateLagMillis = now.Sub(userState.lastPingTime).Milliseconds()
+			if userState.spaceInfoProto != nil {
+				connection.SpaceName = userState.spaceInfoProto.SpaceName
+				for _, anchor := range userState.spaceInfoProto.Anchor {
+					connection.AnchorIds = append(connection.AnchorIds, anchor.Id)
+				}
+			}
+		}
+		resp.Connections = append(resp.Connections, connection)
+	}
+
+	bannedUserNames := make([]string, 0, len(s.bannedUsers))
+	for userName := range s.bannedUsers {
+		bannedUserNames = append(bannedUserNames, userName)
+	}
+	sort.Strings(bannedUserNames)
+	for _, userName := range bannedUserNames {
+		resp.BannedUsers = append(resp.BannedUsers, &pb.ListConnectionsResponse_BannedUser{
+			UserName: userName, Reason: s.bannedUsers[userName]})
+	}
+
+	return resp
+}
+
+// KickUser handles an admin rpc to disconnect a user, optionally banning them from connecting again.
+func (a *AdminServer) KickUser(ctx context.Context, req *pb.KickUserRequest) (*pb.KickUserResponse, error) {
+	s := a.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	adminName, err := s.CheckAdminCredentialLocked(ctx, "kick user")
+	if err != nil {
+		return nil, err
+	}
+	if req.UserName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_name must be set")
+	}
+
+	resp := &pb.KickUserResponse{WasConnected: s.KickUserLocked(req.UserName, req.Reason, req.Ban)}
+	if req.Ban {
+		log.Printf("User %s: Banned user %s (%q, was connected: %v)", adminName, req.UserName, req.Reason,
+			resp.WasConnected)
+	} else {
+		log.Printf("User %s: Kicked user %s (%q, was connected: %v)", adminName, req.UserName, req.Reason,
+			resp.WasConnected)
+	}
+
+	return resp, nil
+}
+
+// UnbanUser handles an admin rpc to allow a banned user to connect again.
+func (a *AdminServer) UnbanUser(ctx context.Context, req *pb.UnbanUserRequest) (*pb.UnbanUserResponse, error) {
+	s := a.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	adminName, err := s.CheckAdminCredentialLocked(ctx, "unban user")
+	if err != nil {
+		return nil, err
+	}
+
+	_, wasBanned := s.bannedUsers[req.UserName]
+	delete(s.bannedUsers, req.UserName)
+	log.Printf("User %s: Unbanned user %s (was banned: %v)", adminName, req.UserName, wasBanned)
+
+	return &pb.UnbanUserResponse{WasBanned: wasBanned}, nil
+}
+
+// ListAnchors handles an admin rpc to list anchors with a summary of the content attached to each.
+func (a *AdminServer) ListAnchors(ctx context.Context, _ *pb.ListAnchorsRequest) (*pb.ListAnchorsResponse, error) {
+	s := a.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, err := s.CheckAdminCredentialLocked(ctx, "list anchors"); err != nil {
+		return nil, err
+	}
+
+	return s.BuildAnchorListLocked(), nil
+}
+
+// BuildAnchorListLocked returns all anchors ordered by id, with a summary of the content attached to each. s.lock must
+// be held while calling this function.
+func (s *Server) BuildAnchorListLocked() *pb.ListAnchorsResponse {
+	anchorIds := make([]string, 0, len(s.anchorStateMap))
+	for anchorId := range s.anchorStateMap {
+		anchorIds = append(anchorIds, anchorId)
+	}
+	sort.Strings(anchorIds)
+
+	resp := &pb.ListAnchorsResponse{}
+	for _, anchorId := range anchorIds {
+		anchorState := s.anchorStateMap[anchorId]
+		anchor := &pb.ListAnchorsResponse_Anchor{
+			Id:                 anchorId,
+			BrushStrokeCount:   int32(len(anchorState.brushStrokes)),
+			ExternalModelCount: int32(len(anchorState.externalModels)),
+			TextNoteCount:      int32(len(anchorState.textNotes)),
+			PrimitiveCount:     int32(len(anchorState.primitives)),
+			MeasurementCount:   int32(len(anchorState.measurements)),
+			ContentGroupCount:  int32(len(anchorState.contentGroups)),
+			CommentCount:       int32(len(anchorState.comments)),
+		}
+		for userName := range anchorState.userSet {
+			anchor.UserNames = append(anchor.UserNames, userName)
+		}
+		sort.Strings(anchor.UserNames)
+		for _, brushStroke := range anchorState.brushStrokes {
+			anchor.BrushPoseCount += int32(len(brushStroke.BrushPose))
+		}
+		resp.Anchors = append(resp.Anchors, anchor)
+	}
+
+	return resp
+}
+
+// DumpAnchor handles an admin rpc to dump all content attached to an anchor.
+func (a *AdminServer) DumpAnchor(ctx context.Context, req *pb.DumpAnchorRequest) (*pb.AnchorContentProto, error) {
+	s := a.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, err := s.CheckAdminCredentialLocked(ctx, "dump anchor"); err != nil {
+		return nil, err
+	}
+
+	return s.DumpAnchorLocked(req.AnchorId)
+}
+
+// DumpAnchorLocked returns all content attached to an anchor, safe to serialize after s.lock is released. s.lock must
+// be held while calling this function.
+func (s *Server) DumpAnchorLocked(anchorId string) (*pb.AnchorContentProto, error) {
+	anchorState, ok := s.anchorStateMap[anchorId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "anchor %s not found", anchorId)
+	}
+
+	// Brush strokes are modified in place as poses are added, so copy them before they are serialized outside the
+	// lock.
+	content := s.BuildAnchorContentLocked(anchorState)
+	for i, brushStroke := range content.BrushStrokes {
+		content.BrushStrokes[i] = proto.Clone(brushStroke).(*pb.BrushStrokeProto)
+	}
+
+	return content, nil
+}
+
+// ClearAnchors handles an admin rpc to remove all content and groups attached to anchors, including content in locked
+// layers.
+func (a *AdminServer) ClearAnchors(ctx context.Context, req *pb.ClearAnchorsRequest) (*pb.ClearContentResponse, error) {
+	s := a.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	adminName, err := s.CheckAdminCredentialLocked(ctx, "clear anchors")
+	if err != nil {
+		return nil, err
+	}
+
+	anchorIds := req.AnchorIds
+	if req.AllAnchors {
+		if len(anchorIds) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "only one of anchor_ids and all_anchors may be set")
+		}
+		for anchorId := range s.anchorStateMap {
+			anchorIds = append(anchorIds, anchorId)
+		}
+	} else if len(anchorIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "one of anchor_ids and all_anchors must be set")
+	}
+	for _, anchorId := range anchorIds {
+		if _, ok := s.anchorStateMap[anchorId]; !ok {
+			return nil, status.Errorf(codes.NotFound, "anchor %s not found", anchorId)
+		}
+	}
+
+	resp := &pb.ClearContentResponse{}
+	for _, anchorId := range anchorIds {
+		anchorResp := s.ClearContentLocked(adminName, &pb.ClearContentRequest{AnchorId: anchorId}, true)
+		proto.Merge(resp, anchorResp)
+
+		anchorState := s.anchorStateMap[anchorId]
+		for groupId := range anchorState.contentGroups {
+			s.RemoveContentGroupLocked(anchorState, groupId, adminName, true)
+		}
+	}
+
+	return resp, nil
+}
+
+// Broadcast handles an admin rpc to send a message to all connected users.
+func (a *AdminServer) Broadcast(ctx context.Context, req *pb.BroadcastRequest) (*pb.BroadcastResponse, error) {
+	s := a.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	adminName, err := s.CheckAdminCredentialLocked(ctx, "broadcast")
+	if err != nil {
+		return nil, err
+	}
+	if req.Text == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "broadcast text must not be empty")
+	}
+
+	message := &pb.BroadcastMessageProto{Text: req.Text, SendTimeMillis: time.Now().UnixMilli()}
+	for _, userConnectionEntry := range s.userConnectionsMap {
+		userConnectionEntry.notifyAboutBroadcasts = append(userConnectionEntry.notifyAboutBroadcasts, message)
+		select {
+		case userConnectionEntry.wakeUp <- true:
+		default:
+		}
+	}
+	log.Printf("User %s: Broadcast message to %d users: %q", adminName, len(s.userConnectionsMap), req.Text)
+	s.PublishEventLocked(pb.ServerEventProto_BROADCAST, adminName, "", "", req.Text)
+
+	return &pb.BroadcastResponse{NumUsers: int32(len(s.userConnectionsMap))}, nil
+}
+
+// SetVerbose handles an admin rpc to enable or disable verbose logging. The setting from the config file is restored
+// when the config is next reloaded.
+func (a *AdminServer) SetVerbose(ctx context.Context, req *pb.SetVerboseRequest) (*pb.SetVerboseResponse, error) {
+	s := a.server
+	adminName, err := func() (string, error) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		return s.CheckAdminCredentialLocked(ctx, "set verbose")
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	config := *s.Config()
+	resp := &pb.SetVerboseResponse{PreviousVerbose: config.Verbose}
+	config.Verbose = req.Verbose
+	s.ApplyConfig(&config)
+	log.Printf("User %s: Set verbose logging to %v", adminName, req.Verbose)
+
+	return resp, nil
+}
+
+// IsIdInUseLocked checks whether content, a group or a comment with the provided id exists on any anchor. Clients key
+// content, groups and comments by id alone, so imported ids must not be reused on a second anchor. s.lock must be
+// held while calling this function.
+func (s *Server) IsIdInUseLocked(id string) bool {
+	for _, anchorState := range s.anchorStateMap {
+		if anchorState.HasContent(id) {
+			return true
+		}
+		if _, ok := anchorState.contentGroups[id]; ok {
+			return true
+		}
+		if _, ok := anchorState.comments[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ImportAnchorContentLocked adds the content, groups and comments from a dump of an anchor whose ids don't already
+// exist on any anchor, and distributes them to all users. Returns the number of items imported and skipped. s.lock
+// must be held while calling this function.
+func (s *Server) ImportAnchorContentLocked(adminName string, content *pb.AnchorContentProto) (int, int) {
+	anchorState, ok := s.anchorStateMap[content.AnchorId]
+	if !ok {
+		anchorState = &AnchorState{id: content.AnchorId}
+		anchorState.Init()
+		s.anchorStateMap[content.AnchorId] = anchorState
+	}
+
+	numImported := 0
+	numSkipped := 0
+	// Creation times are offset by the import order so that later dumps keep the same order.
+	importTime := time.Now()
+	addContentInfo := func(contentId string, createdByUserName string) {
+		anchorState.contentInfo[contentId] = &ContentInfo{
+			createdByUserName: createdByUserName, createTime: importTime.Add(time.Duration(numImported))}
+		numImported++
+	}
+
+	var brushStrokeIds []string
+	for _, brushStroke := range content.BrushStrokes {
+		if brushStroke.Id == "" || s.IsIdInUseLocked(brushStroke.Id) {
+			numSkipped++
+			continue
+		}
+		brushStroke = proto.Clone(brushStroke).(*pb.BrushStrokeProto)
+		brushStroke.AnchorId = anchorState.id
+		brushStroke.StartIndex = 0
+		anchorState.brushStrokes[brushStroke.Id] = brushStroke
+		addContentInfo(brushStroke.Id, brushStroke.UserName)
+		brushStrokeIds = append(brushStrokeIds, brushStroke.Id)
+	}
+	var modelIds []string
+	for _, model := range content.ExternalModels {
+		if model.Id == "" || s.IsIdInUseLocked(model.Id) {
+			numSkipped++
+			continue
+		}
+		model = proto.Clone(model).(*pb.ExternalModelProto)
+		model.AnchorId = anchorState.id
+		anchorState.externalModels[model.Id] = model
+		addContentInfo(model.Id, model.ModifiedByUserName)
+		modelIds = append(modelIds, model.Id)
+	}
+	for _, textNote := range content.TextNotes {
+		if textNote.Id == "" || s.IsIdInUseLocked(textNote.Id) {
+			numSkipped++
+			continue
+		}
+		textNote = proto.Clone(textNote).(*pb.TextNoteProto)
+		textNote.AnchorId = anchorState.id
+		anchorState.textNotes[textNote.Id] = textNote
+		addContentInfo(textNote.Id, textNote.UserName)
+		s.DistributeTextNoteAddLocked(anchorState, textNote.Id, adminName, true)
+		s.PublishEventLocked(pb.ServerEventProto_TEXT_NOTE_ADDED, adminName, anchorState.id, textNote.Id, "imported")
+	}
+	for _, primitive := range content.Primitives {
+		if primitive.Id == "" || s.IsIdInUseLocked(primitive.Id) {
+			numSkipped++
+			continue
+		}
+		primitive = proto.Clone(primitive).(*pb.PrimitiveProto)
+		primitive.AnchorId = anchorState.id
+		anchorState.primitives[primitive.Id] = primitive
+		addContentInfo(primitive.Id, primitive.UserName)
+		s.DistributePrimitiveAddLocked(anchorState, primitive.Id, adminName, true)
+		s.PublishEventLocked(pb.ServerEventProto_PRIMITIVE_ADDED, adminName, anchorState.id, primitive.Id, "imported")
+	}
+	for _, measurement := range content.Measurements {
+		if measurement.Id == "" || s.IsIdInUseLocked(measurement.Id) {
+			numSkipped++
+			continue
+		}
+		measurement = proto.Clone(measurement).(*pb.MeasurementProto)
+		measurement.AnchorId = anchorState.id
+		anchorState.measurements[measurement.Id] = measurement
+		addContentInfo(measurement.Id, measurement.UserName)
+		s.DistributeMeasurementAddLocked(anchorState, measurement.Id, adminName, true)
+		s.PublishEventLocked(pb.ServerEventProto_MEASUREMENT_ADDED, adminName, anchorState.id, measurement.Id,
+			"imported")
+	}
+
+	// Parents are checked once all brush strokes and 3D models are added, since a parent may be listed after its
+	// children.
+	for _, brushStrokeId := range brushStrokeIds {
+		brushStroke := anchorState.brushStrokes[brushStrokeId]
+		if err := anchorState.CheckContentParent(brushStrokeId, brushStroke.ParentId); err != nil {
+			log.Printf("User %s: *** Warning: detaching imported brush stroke %s: %v", adminName, brushStrokeId, err)
+			brushStroke.ParentId = ""
+		}
+		s.DistributeBrushStrokeAddLocked(anchorState, brushStrokeId, 0, adminName, true)
+		s.PublishEventLocked(pb.ServerEventProto_BRUSH_STROKE_ADDED, adminName, anchorState.id, brushStrokeId,
+			"imported")
+	}
+	for _, modelId := range modelIds {
+		model := anchorState.externalModels[modelId]
+		if err := anchorState.CheckContentParent(modelId, model.GetParentId()); err != nil {
+			log.Printf("User %s: *** Warning: detaching imported model %s: %v", adminName, modelId, err)
+			model.ParentId = proto.String("")
+		}
+		s.DistributeExternalModelAddLocked(anchorState, modelId, adminName, true)
+		s.PublishEventLocked(pb.ServerEventProto_EXTERNAL_MODEL_ADDED, adminName, anchorState.id, modelId,
+			model.FileName)
+	}
+
+	for _, group := range content.ContentGroups {
+		if group.Id == "" || s.IsIdInUseLocked(group.Id) {
+			numSkipped++
+			continue
+		}
+		group = proto.Clone(group).(*pb.ContentGroupProto)
+		group.AnchorId = anchorState.id
+		s.SetContentGroupLocked(anchorState, group, adminName, true)
+		numImported++
+	}
+
+	// Comments are listed in creation order, so replies are imported after the comments they reply to.
+	for _, comment := range content.Comments {
+		if comment.Id == "" || s.IsIdInUseLocked(comment.Id) || !anchorState.HasContent(comment.ContentId) {
+			numSkipped++
+			continue
+		}
+		if comment.ParentCommentId != "" {
+			if parentComment, ok := anchorState.comments[comment.ParentCommentId]; !ok ||
+				parentComment.ContentId != comment.ContentId {
+				numSkipped++
+				continue
+			}
+		}
+		comment = proto.Clone(comment).(*pb.CommentProto)
+		comment.AnchorId = anchorState.id
+		anchorState.comments[comment.Id] = comment
+		s.DistributeCommentAddLocked(anchorState, comment.Id, adminName, true)
+		s.PublishEventLocked(pb.ServerEventProto_COMMENT_ADDED, adminName, anchorState.id, comment.Id, comment.Text)
+		numImported++
+	}
+
+	return numImported, numSkipped
+}
+
+// Snapshot handles an admin rpc to dump all anchors and layers at a single point in time.
+func (a *AdminServer) Snapshot(ctx context.Context, _ *pb.SnapshotRequest) (*pb.ServerSnapshotProto, error) {
+	s := a.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	adminName, err := s.CheckAdminCredentialLocked(ctx, "snapshot")
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &pb.ServerSnapshotProto{
+		SnapshotTimeMillis: time.Now().UnixMilli(),
+		ServerVersion:      serverVersion,
+		Layers:             s.BuildLayerListLocked("").Layers,
+		RoomSettings:       s.roomSettings,
+	}
+	anchorIds := make([]string, 0, len(s.anchorStateMap))
+	for anchorId := range s.anchorStateMap {
+		anchorIds = append(anchorIds, anchorId)
+	}
+	sort.Strings(anchorIds)
+	for _, anchorId := range anchorIds {
+		content := s.BuildAnchorContentLocked(s.anchorStateMap[anchorId])
+		for i, brushStroke := range content.BrushStrokes {
+			content.BrushStrokes[i] = proto.Clone(brushStroke).(*pb.BrushStrokeProto)
+		}
+		snapshot.Anchors = append(snapshot.Anchors, content)
+	}
+
+	log.Printf("User %s: Took snapshot of %d anchors", adminName, len(snapshot.Anchors))
+
+	return snapshot, nil
+}
+
+// Import handles an admin rpc to add content, layers and room settings from a dump or snapshot.
+func (a *AdminServer) Import(ctx context.Context, req *pb.ImportRequest) (*pb.ImportResponse, error) {
+	s := a.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	adminName, err := s.CheckAdminCredentialLocked(ctx, "import")
+	if err != nil {
+		return nil, err
+	}
+	for _, content := range req.Anchors {
+		if content.AnchorId == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "imported anchor_id must not be empty")
+		}
+	}
+	for _, layer := range req.Layers {
+		if layer.Id == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "imported layer id must not be empty")
+		}
+	}
+
+	// Room settings are restored before content, so that imported measurements keep labels in the same unit system.
+	if req.RoomSettings != nil {
+		err := s.HandleSetRoomSettingsLocked(adminName, &pb.SetRoomSettingsRequest{Settings: req.RoomSettings})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &pb.ImportResponse{}
+	for _, layer := range req.Layers {
+		layer = proto.Clone(layer).(*pb.LayerProto)
+		layer.UserVisible = nil
+		s.layers[layer.Id] = layer
+		resp.NumLayers++
+	}
+	if len(req.Layers) > 0 {
+		s.DistributeLayersLocked()
+	}
+	for _, content := range req.Anchors {
+		numImported, numSkipped := s.ImportAnchorContentLocked(adminName, content)
+		resp.NumImported += int32(numImported)
+		resp.NumSkipped += int32(numSkipped)
+	}
+
+	log.Printf("User %s: Imported %d items and %d layers into %d anchors, skipped %d existing or invalid items",
+		adminName, resp.NumImported, resp.NumLayers, len(req.Anchors), resp.NumSkipped)
+
+	return resp, nil
+}
+
+// TailEvents handles an admin rpc to follow a stream of user and content events as they happen. The stream ends when
+// the server shuts down.
+func (a *AdminServer) TailEvents(req *pb.TailEventsRequest, stream pb.LeapBrushAdmin_TailEventsServer) error {
+	s := a.server
+	adminName, err := func() (string, error) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		return s.CheckAdminCredentialLocked(stream.Context(), "tail events")
+	}()
+	if err != nil {
+		return err
+	}
+
+	subscriber := s.SubscribeEvents(adminName)
+	defer s.UnsubscribeEvents(subscriber)
+	log.Printf("User %s: Started following events", adminName)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			log.Printf("User %s: Stopped following events", adminName)
+			return nil
+		case event, ok := <-subscriber.events:
+			if !ok {
+				return nil
+			}
+			if req.AnchorId != "" && event.AnchorId != "" && event.AnchorId != req.AnchorId {
+				continue
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,581 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Size of each chunk of file data sent when downloading a model.
+	modelDownloadChunkSize = 64 * 1024
+
+	// Suffix for the metadata file stored next to each asset.
+	assetMetadataSuffix = ".json"
+
+	// Maximum length in bytes of an uploaded model file name.
+	maxModelFileNameLength = 255
+)
+
+// contentHashRegexp matches a valid content hash: a lowercase hex encoded SHA-256.
+var contentHashRegexp = regexp.MustCompile("^[0-9a-f]{64}$")
+
+// AssetInfo describes a model file stored in the asset store.
+type AssetInfo struct {
+	// The lowercase hex encoded SHA-256 of the file data.
+	ContentHash string
+	// The file name that the model was uploaded with.
+	FileName string
+	// The size of the file in bytes.
+	SizeBytes int64
+	// The user identifier for the user that first uploaded the file.
+	UploadedByUserName string
+	// The time the file was first uploaded.
+	UploadTime time.Time
+
+	// The path of the file data.
+	path string
+	// Whether the file was found in the model catalog directory rather than uploaded.
+	fromCatalogDir bool
+	// The modification time of a model catalog directory file when it was loaded.
+	modTime time.Time
+	// Metadata computed from the model, or nil if a model catalog directory file is invalid.
+	modelInfo *GltfModelInfo
+}
+
+// AssetStore stores uploaded model files on disk, addressed by the hash of their content, and serves the model files
+// in an optional catalog directory. An AssetStore has its own lock and may be used while holding the server lock, as
+// file io is never done while holding the AssetStore lock.
+type AssetStore struct {
+	// Directory that uploaded files are stored in.
+	dir string
+
+	// Lock to protect cross-thread accessed data
+	lock sync.Mutex
+	// Directory containing model files to serve in addition to uploaded files, or empty if none.
+	catalogDir string
+	// Maximum size in bytes of a single uploaded file.
+	maxSizeBytes int64
+	// Limits that every model must be within.
+	budgets GltfBudgets
+	// Map from content hash to uploaded asset.
+	assets map[string]*AssetInfo
+	// Map from file path to asset in the model catalog directory.
+	catalogAssets map[string]*AssetInfo
+}
+
+// Init creates the asset directory if needed and loads all assets already stored in it and in the model catalog
+// directory.
+func (a *AssetStore) Init() error {
+	a.assets = make(map[string]*AssetInfo)
+	a.catalogAssets = make(map[string]*AssetInfo)
+
+	if err := os.MkdirAll(a.dir, 0755); err != nil {
+		return err
+	}
+	metadataPaths, err := filepath.Glob(filepath.Join(a.dir, "*"+assetMetadataSuffix))
+	if err != nil {
+		return err
+	}
+	for _, metadataPath := range metadataPaths {
+		contentHash := strings.TrimSuffix(filepath.Base(metadataPath), assetMetadataSuffix)
+		if !contentHashRegexp.MatchString(contentHash) {
+			continue
+		}
+		metadata, err := os.ReadFile(metadataPath)
+		if err != nil {
+			log.Printf("*** Warning: failed to read asset metadata %s: %v", metadataPath, err)
+			continue
+		}
+		info := &AssetInfo{}
+		if err := json.Unmarshal(metadata, info); err != nil || info.ContentHash != contentHash {
+			log.Printf("*** Warning: ignoring invalid asset metadata %s", metadataPath)
+			continue
+		}
+		info.path = a.assetPath(contentHash)
+		if info.modelInfo, err = a.LoadModelFile(info.path, info.FileName); err != nil {
+			log.Printf("*** Warning: ignoring invalid asset %s: %v", contentHash, err)
+			continue
+		}
+		a.assets[contentHash] = info
+	}
+
+	if err := a.ScanCatalogDir(); err != nil {
+		return err
+	}
+
+	log.Printf("Asset store at %s loaded with %d uploaded models", a.dir, len(a.assets))
+	return nil
+}
+
+// ScanCatalogDir updates the assets in the model catalog directory, loading files that are new or modified since the
+// last scan.
+func (a *AssetStore) ScanCatalogDir() error {
+	a.lock.Lock()
+	catalogDir := a.catalogDir
+	previousCatalogAssets := a.catalogAssets
+	a.lock.Unlock()
+
+	catalogAssets := make(map[string]*AssetInfo)
+	if catalogDir == "" {
+		a.lock.Lock()
+		defer a.lock.Unlock()
+
+		if a.catalogDir == catalogDir {
+			a.catalogAssets = catalogAssets
+		}
+		return nil
+	}
+
+	err := filepath.WalkDir(catalogDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || ValidateModelFileName(path) != nil {
+			return nil
+		}
+		fileInfo, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if info, ok := previousCatalogAssets[path]; ok &&
+			info.modTime.Equal(fileInfo.ModTime()) && info.SizeBytes == fileInfo.Size() {
+			catalogAssets[path] = info
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		hash := sha256.Sum256(data)
+		info := &AssetInfo{
+			ContentHash:    hex.EncodeToString(hash[:]),
+			FileName:       filepath.Base(path),
+			SizeBytes:      int64(len(data)),
+			UploadTime:     fileInfo.ModTime(),
+			path:           path,
+			fromCatalogDir: true,
+			modTime:        fileInfo.ModTime(),
+		}
+		// Invalid files are kept so that they are only loaded again if modified.
+		if info.modelInfo, err = a.LoadModelFile(path, path); err != nil {
+			log.Printf("*** Warning: ignoring invalid catalog model %s: %v", path, err)
+		}
+		catalogAssets[path] = info
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to scan model catalog directory: %v", err)
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	// Drop the results if the catalog directory was changed during the scan.
+	if a.catalogDir == catalogDir {
+		a.catalogAssets = catalogAssets
+	}
+	return nil
+}
+
+// Limits returns the maximum size in bytes of a single uploaded file, and the limits that every model must be within.
+func (a *AssetStore) Limits() (int64, GltfBudgets) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.maxSizeBytes, a.budgets
+}
+
+// SetLimits changes the maximum upload size and the limits that every model must be within. Models that are already
+// stored are not checked again.
+func (a *AssetStore) SetLimits(maxSizeBytes int64, budgets GltfBudgets) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.maxSizeBytes = maxSizeBytes
+	a.budgets = budgets
+}
+
+// SetCatalogDir changes the model catalog directory, which is loaded on the next call to ScanCatalogDir.
+func (a *AssetStore) SetCatalogDir(catalogDir string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if catalogDir != a.catalogDir {
+		a.catalogDir = catalogDir
+		a.catalogAssets = make(map[string]*AssetInfo)
+	}
+}
+
+// ListModels returns catalog entries for all uploaded and catalog directory models with the provided tag, or all
+// models if tag is empty, sorted by name.
+func (a *AssetStore) ListModels(tag string) []*pb.ModelCatalogEntryProto {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	var models []*pb.ModelCatalogEntryProto
+	listedContentHashes := make(map[string]bool)
+	addModel := func(info *AssetInfo) {
+		if info.modelInfo == nil || listedContentHashes[info.ContentHash] {
+			return
+		}
+		if tag != "" && !containsString(info.modelInfo.Tags, tag) {
+			return
+		}
+		listedContentHashes[info.ContentHash] = true
+
+		entry := &pb.ModelCatalogEntryProto{
+			Name:               info.modelInfo.Title,
+			FileName:           info.FileName,
+			ContentHash:        info.ContentHash,
+			SizeBytes:          info.SizeBytes,
+			BoundsMin:          Vec3ToProto(info.modelInfo.BoundsMin),
+			BoundsMax:          Vec3ToProto(info.modelInfo.BoundsMax),
+			TriangleCount:      info.modelInfo.TriangleCount,
+			Tags:               info.modelInfo.Tags,
+			UploadedByUserName: info.UploadedByUserName,
+		}
+		if entry.Name == "" {
+			entry.Name = strings.TrimSuffix(info.FileName, filepath.Ext(info.FileName))
+		}
+		if info.fromCatalogDir {
+			entry.Source = pb.ModelCatalogEntryProto_CATALOG_DIR
+		}
+		models = append(models, entry)
+	}
+	for _, info := range a.catalogAssets {
+		addModel(info)
+	}
+	for _, info := range a.assets {
+		addModel(info)
+	}
+
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].Name != models[j].Name {
+			return models[i].Name < models[j].Name
+		}
+		return models[i].ContentHash < models[j].ContentHash
+	})
+	return models
+}
+
+// Add stores a model file read from data, and returns the stored asset. If an asset with the same content already
+// exists, the existing asset is returned. Returns a grpc status error if the file is too large or invalid.
+func (a *AssetStore) Add(userName string, fileName string, expectedContentHash string, data io.Reader) (*AssetInfo, error) {
+	fileName, err := SanitizeModelFileName(fileName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	tempFile, err := os.CreateTemp(a.dir, "upload-*")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create upload file: %v", err)
+	}
+	defer os.Remove(tempFile.Name())
+	defer tempFile.Close()
+
+	maxSizeBytes, _ := a.Limits()
+	hash := sha256.New()
+	sizeBytes, err := io.Copy(io.MultiWriter(tempFile, hash), io.LimitReader(data, maxSizeBytes+1))
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to write upload file: %v", err)
+	}
+	if sizeBytes > maxSizeBytes {
+		return nil, status.Errorf(codes.ResourceExhausted, "model exceeds the maximum size of %d bytes",
+			maxSizeBytes)
+	}
+	contentHash := hex.EncodeToString(hash.Sum(nil))
+	if expectedContentHash != "" && expectedContentHash != contentHash {
+		return nil, status.Errorf(codes.DataLoss, "content hash %s does not match the expected hash %s",
+			contentHash, expectedContentHash)
+	}
+	if err := tempFile.Close(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write upload file: %v", err)
+	}
+	modelInfo, err := a.LoadModelFile(tempFile.Name(), fileName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid model %s: %v", fileName, err)
+	}
+
+	a.lock.Lock()
+	info, ok := a.assets[contentHash]
+	a.lock.Unlock()
+	if ok {
+		return info, nil
+	}
+
+	info = &AssetInfo{
+		ContentHash:        contentHash,
+		FileName:           fileName,
+		SizeBytes:          sizeBytes,
+		UploadedByUserName: userName,
+		UploadTime:         time.Now(),
+		path:               a.assetPath(contentHash),
+		modelInfo:          modelInfo,
+	}
+	metadata, err := json.Marshal(info)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode asset metadata: %v", err)
+	}
+
+	// The files are stored without holding the lock. Existing files are kept rather than replaced, as they were stored
+	// by a concurrent upload of the same content.
+	createdFile, err := LinkIfAbsent(tempFile.Name(), info.path)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store model: %v", err)
+	}
+	if err := a.storeMetadata(info.path+assetMetadataSuffix, metadata); err != nil {
+		if createdFile {
+			os.Remove(info.path)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to store asset metadata: %v", err)
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if existingInfo, ok := a.assets[contentHash]; ok {
+		return existingInfo, nil
+	}
+	a.assets[contentHash] = info
+
+	return info, nil
+}
+
+// storeMetadata writes the metadata file for an asset, unless a concurrent upload of the same content already wrote
+// it.
+func (a *AssetStore) storeMetadata(path string, metadata []byte) error {
+	tempFile, err := os.CreateTemp(a.dir, "upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+	defer tempFile.Close()
+
+	if _, err := tempFile.Write(metadata); err != nil {
+		return err
+	}
+	if err := tempFile.Chmod(0644); err != nil {
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	_, err = LinkIfAbsent(tempFile.Name(), path)
+	return err
+}
+
+// LinkIfAbsent creates newPath as a hard link to oldPath unless newPath already exists. Returns whether newPath was
+// created.
+func LinkIfAbsent(oldPath string, newPath string) (bool, error) {
+	if err := os.Link(oldPath, newPath); err != nil {
+		if os.IsExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Get returns the uploaded or catalog directory asset with the provided content hash.
+func (a *AssetStore) Get(contentHash string) (*AssetInfo, bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if info, ok := a.assets[contentHash]; ok {
+		return info, true
+	}
+	for _, info := range a.catalogAssets {
+		if info.ContentHash == contentHash && info.modelInfo != nil {
+			return info, true
+		}
+	}
+	return nil, false
+}
+
+// Open opens the file data for the stored asset with the provided content hash. The caller must close the file.
+func (a *AssetStore) Open(contentHash string) (*os.File, *AssetInfo, error) {
+	info, ok := a.Get(contentHash)
+	if !ok {
+		return nil, nil, status.Errorf(codes.NotFound, "model %s not found", contentHash)
+	}
+	file, err := os.Open(info.path)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to open model %s: %v", contentHash, err)
+	}
+	return file, info, nil
+}
+
+func (a *AssetStore) assetPath(contentHash string) string {
+	return filepath.Join(a.dir, contentHash)
+}
+
+// ValidateModelFileName checks that a model file name has a supported extension.
+func ValidateModelFileName(fileName string) error {
+	ext := strings.ToLower(filepath.Ext(fileName))
+	if ext != ".glb" && ext != ".gltf" {
+		return fmt.Errorf("model file name %q must end in .glb or .gltf", fileName)
+	}
+	return nil
+}
+
+// SanitizeModelFileName returns the base name of an uploaded model file name, checking that it has a supported
+// extension and contains no control characters.
+func SanitizeModelFileName(fileName string) (string, error) {
+	fileName = filepath.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	if len(fileName) > maxModelFileNameLength {
+		return "", fmt.Errorf("model file name is longer than %d bytes", maxModelFileNameLength)
+	}
+	for _, r := range fileName {
+		if unicode.IsControl(r) || r == utf8.RuneError {
+			return "", fmt.Errorf("model file name %q contains invalid characters", fileName)
+		}
+	}
+	if err := ValidateModelFileName(fileName); err != nil {
+		return "", err
+	}
+	return fileName, nil
+}
+
+// LoadModelFile parses and validates a .glb or .gltf model file, and computes its metadata.
+func (a *AssetStore) LoadModelFile(path string, fileName string) (*GltfModelInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	_, budgets := a.Limits()
+	return LoadGltf(data, fileName, budgets)
+}
+
+// containsString checks whether a list of strings contains a value.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// uploadModelReader adapts a model upload stream to an io.Reader of the file data.
+type uploadModelReader struct {
+	uploadServer pb.LeapBrushApi_UploadModelServer
+	// Remaining data from the last received message.
+	pending []byte
+}
+
+func (r *uploadModelReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		req, err := r.uploadServer.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.Info != nil {
+			return 0, status.Errorf(codes.InvalidArgument, "info may only be set in the first message")
+		}
+		r.pending = req.Data
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// UploadModel handles a client stream uploading a model file to the asset store.
+func (s *Server) UploadModel(uploadServer pb.LeapBrushApi_UploadModelServer) error {
+	req, err := uploadServer.Recv()
+	if err != nil {
+		return err
+	}
+	if req.Info == nil {
+		return status.Errorf(codes.InvalidArgument, "info must be set in the first message")
+	}
+	if maxSizeBytes, _ := s.assetStore.Limits(); req.Info.SizeBytes > maxSizeBytes {
+		return status.Errorf(codes.ResourceExhausted, "model exceeds the maximum size of %d bytes", maxSizeBytes)
+	}
+	if req.Info.ContentHash != "" {
+		if info, ok := s.assetStore.Get(req.Info.ContentHash); ok {
+			// The model is already stored, so the rest of the upload can be skipped.
+			return uploadServer.SendAndClose(&pb.UploadModelResponse{
+				ContentHash: info.ContentHash, SizeBytes: info.SizeBytes})
+		}
+	}
+
+	info, err := s.assetStore.Add(req.Info.UserName, req.Info.FileName, req.Info.ContentHash,
+		&uploadModelReader{uploadServer: uploadServer, pending: req.Data})
+	if err != nil {
+		log.Printf("User %s: *** Warning: failed to upload model %s: %v", req.Info.UserName, req.Info.FileName, err)
+		return err
+	}
+
+	log.Printf("User %s: Uploaded model %s (%s, %d bytes)",
+		req.Info.UserName, req.Info.FileName, info.ContentHash, info.SizeBytes)
+
+	return uploadServer.SendAndClose(&pb.UploadModelResponse{ContentHash: info.ContentHash, SizeBytes: info.SizeBytes})
+}
+
+// HandleQueryModelCatalog handles an rpc from a user to list the models available on the server. This reads from disk,
+// so s.lock must not be held while calling this function.
+func (s *Server) HandleQueryModelCatalog(userName string, req *pb.QueryModelCatalogRequest) (*pb.QueryModelCatalogResponse, error) {
+	if err := s.assetStore.ScanCatalogDir(); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp := &pb.QueryModelCatalogResponse{Models: s.assetStore.ListModels(req.Tag)}
+
+	log.Printf("User %s: Queried model catalog, %d results returned", userName, len(resp.Models))
+
+	return resp, nil
+}
+
+// DownloadModel handles a request to stream a model file from the asset store.
+func (s *Server) DownloadModel(req *pb.DownloadModelRequest, downloadServer pb.LeapBrushApi_DownloadModelServer) error {
+	file, info, err := s.assetStore.Open(req.ContentHash)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	log.Printf("User %s: Downloading model %s (%s, %d bytes)",
+		req.UserName, info.FileName, info.ContentHash, info.SizeBytes)
+
+	resp := &pb.DownloadModelResponse{FileName: info.FileName, SizeBytes: info.SizeBytes}
+	buf := make([]byte, modelDownloadChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			resp.Data = buf[:n]
+			if err := downloadServer.Send(resp); err != nil {
+				return err
+			}
+			resp = &pb.DownloadModelResponse{}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read model %s: %v", req.ContentHash, err)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Maximum length in bytes for the text of a comment.
+	maxCommentLength = 4096
+)
+
+// HandleCommentAddLocked handles a comment that was added or modified by a user. s.lock must be held while calling
+// this function.
+func (s *Server) HandleCommentAddLocked(userName string, req *pb.CommentAddRequest, echo bool) {
+	if req.Comment == nil {
+		return
+	}
+	anchorState, ok := s.anchorStateMap[req.Comment.AnchorId]
+	if !ok {
+		return
+	}
+	if len(req.Comment.Text) > maxCommentLength {
+		log.Printf("User %s: *** Warning: ignoring comment %s with text length %d > %d",
+			userName, req.Comment.Id, len(req.Comment.Text), maxCommentLength)
+		return
+	}
+
+	nowMillis := time.Now().UnixMilli()
+
+	var comment *pb.CommentProto
+	if existingComment, ok := anchorState.comments[req.Comment.Id]; ok {
+		if req.Comment.Text != existingComment.Text && userName != existingComment.AuthorUserName {
+			log.Printf("User %s: *** Warning: ignoring edit to comment %s by %s",
+				userName, existingComment.Id, existingComment.AuthorUserName)
+			s.ResendCommentToUserLocked(anchorState, existingComment.Id, userName)
+			return
+		}
+		comment = proto.Clone(existingComment).(*pb.CommentProto)
+		comment.Text = req.Comment.Text
+		comment.Resolved = req.Comment.Resolved
+	} else {
+		if !anchorState.HasContent(req.Comment.ContentId) {
+			log.Printf("User %s: *** Warning: ignoring comment %s for unknown content %s",
+				userName, req.Comment.Id, req.Comment.ContentId)
+			return
+		}
+		if req.Comment.ParentCommentId != "" {
+			if parentComment, ok := anchorState.comments[req.Comment.ParentCommentId]; !ok ||
+				parentComment.ContentId != req.Comment.ContentId {
+				log.Printf("User %s: *** Warning: ignoring comment %s replying to unknown comment %s",
+					userName, req.Comment.Id, req.Comment.ParentCommentId)
+				return
+			}
+		}
+		comment = proto.Clone(req.Comment).(*pb.CommentProto)
+		comment.AuthorUserName = userName
+		comment.AuthorDisplayName = ""
+		if userStateEntry, ok := s.userStateMap[userName]; ok && userStateEntry.stateProto != nil {
+			comment.AuthorDisplayName = userStateEntry.stateProto.UserDisplayName
+		}
+		comment.CreateTimeMillis = nowMillis
+		s.PublishEventLocked(pb.ServerEventProto_COMMENT_ADDED, userName, anchorState.id, comment.Id, comment.Text)
+	}
+	comment.ModifiedTimeMillis = nowMillis
+	anchorState.comments[comment.Id] = comment
+
+	s.DistributeCommentAddLocked(anchorState, comment.Id, userName, echo)
+	if s.verbose {
+		log.Printf("User %s: Create or update comment %s on %s for anchor %s (resolved %v)",
+			userName, comment.Id, comment.ContentId, anchorState.id, comment.Resolved)
+	}
+}
+
+// HandleCommentRemoveLocked handles a comment that was removed by a user. s.lock must be held while calling this
+// function.
+func (s *Server) HandleCommentRemoveLocked(userName string, req *pb.CommentRemoveRequest, echo bool) {
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok {
+		return
+	}
+	comment, ok := anchorState.comments[req.Id]
+	if !ok {
+		return
+	}
+	if comment.AuthorUserName != userName {
+		log.Printf("User %s: *** Warning: ignoring removal of comment %s by %s",
+			userName, comment.Id, comment.AuthorUserName)
+		s.ResendCommentToUserLocked(anchorState, comment.Id, userName)
+		return
+	}
+
+	s.RemoveCommentLocked(anchorState, req.Id, userName, echo)
+	if s.verbose {
+		log.Printf("User %s: Removed comment %s from anchor %s", userName, req.Id, anchorState.id)
+	}
+}
+
+// HandleQueryCommentsLocked handles an rpc from a user to list comments on an anchor. s.lock must be held while
+// calling this function.
+func (s *Server) HandleQueryCommentsLocked(userName string, req *pb.QueryCommentsRequest) (*pb.QueryCommentsResponse, error) {
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "anchor %s not found", req.AnchorId)
+	}
+
+	resp := &pb.QueryCommentsResponse{}
+	for _, comment := range anchorState.comments {
+		if req.ContentId != "" && req.ContentId != comment.ContentId {
+			continue
+		}
+		if comment.Resolved && !req.IncludeResolved {
+			continue
+		}
+		resp.Comments = append(resp.Comments, comment)
+	}
+	sort.Slice(resp.Comments, func(i, j int) bool {
+		if resp.Comments[i].CreateTimeMillis != resp.Comments[j].CreateTimeMillis {
+			return resp.Comments[i].CreateTimeMillis < resp.Comments[j].CreateTimeMillis
+		}
+		return resp.Comments[i].Id < resp.Comments[j].Id
+	})
+
+	if s.verbose {
+		log.Printf("User %s: Queried comments on anchor %s, %d results returned",
+			userName, anchorState.id, len(resp.Comments))
+	}
+
+	return resp, nil
+}
+
+// RemoveCommentLocked removes a comment and all replies to it from an anchor, and distributes the removals to other
+// users. s.lock must be held while calling this function.
+func (s *Server) RemoveCommentLocked(anchorState *AnchorState, commentId string, senderUserName string, echo bool) {
+	if _, ok := anchorState.comments[commentId]; !ok {
+		return
+	}
+	delete(anchorState.comments, commentId)
+	s.DistributeCommentRemoveLocked(anchorState, commentId, senderUserName, echo)
+	s.PublishEventLocked(pb.ServerEventProto_COMMENT_REMOVED, senderUserName, anchorState.id, commentId, "")
+
+	var replyIds []string
+	for replyId, reply := range anchorState.comments {
+		if reply.ParentCommentId == commentId {
+			replyIds = append(replyIds, replyId)
+		}
+	}
+	for _, replyId := range replyIds {
+		s.RemoveCommentLocked(anchorState, replyId, senderUserName, echo)
+	}
+}
+
+// RemoveContentCommentsLocked removes all comments attached to a piece of content, and distributes the removals to
+// other users. s.lock must be held while calling this function.
+func (s *Server) RemoveContentCommentsLocked(anchorState *AnchorState, contentId string, senderUserName string, echo bool) {
+	var commentIds []string
+	for commentId, comment := range anchorState.comments {
+		if comment.ContentId == contentId {
+			commentIds = append(commentIds, commentId)
+		}
+	}
+	for _, commentId := range commentIds {
+		s.RemoveCommentLocked(anchorState, commentId, senderUserName, echo)
+	}
+}
+
+// ResendCommentToUserLocked sends the server's version of a comment to a user whose edit to it was rejected.
+// s.lock must be held while calling this function.
+func (s *Server) ResendCommentToUserLocked(anchorState *AnchorState, commentId string, userName string) {
+	if userConnectionEntry, ok := s.userConnectionsMap[userName]; ok {
+		userConnectionEntry.notifyAboutCommentAdds[commentId] = anchorState.id
+		select {
+		case userConnectionEntry.wakeUp <- true:
+		default:
+		}
+	}
+}
+
+// DistributeCommentAddLocked sets notification bits for user connections, for a comment that was added or
+// modified. s.lock must be held while calling this function.
+func (s *Server) DistributeCommentAddLocked(anchorState *AnchorState, commentId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutCommentRemovals, commentId)
+			userConnectionEntry.notifyAboutCommentAdds[commentId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeCommentRemoveLocked sets notification bits for user connections, for a comment that was removed.
+// s.lock must be held while calling this function.
+func (s *Server) DistributeCommentRemoveLocked(anchorState *AnchorState, commentId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutCommentAdds, commentId)
+			userConnectionEntry.notifyAboutCommentRemovals[commentId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeMissingCommentsToUserLocked sets notify bits for all comments that a user hasn't been notified about
+// yet. s.lock must be held while calling this function.
+func (s *Server) DistributeMissingCommentsToUserLocked(userStateEntry *UserState, userConnectionState *UserConnectionState) {
+	if userStateEntry == nil {
+		userStateEntry = s.userStateMap[userConnectionState.userName]
+	}
+	if userConnectionState == nil {
+		userConnectionState = s.userConnectionsMap[userStateEntry.userName]
+	}
+	if userStateEntry == nil || userConnectionState == nil || userStateEntry.spaceInfoProto == nil {
+		return
+	}
+
+	for _, anchor := range userStateEntry.spaceInfoProto.Anchor {
+		if anchorState, ok := s.anchorStateMap[anchor.Id]; ok {
+			for commentId := range anchorState.comments {
+				userConnectionState.notifyAboutCommentAdds[commentId] = anchor.Id
+			}
+		}
+	}
+}
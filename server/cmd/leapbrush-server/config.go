@@ -0,0 +1,317 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+// Config contains the server settings that can be set in a YAML (or JSON) config file. Settings missing from the file
+// keep their defaults, and command line flags override the file. The file is reloaded on SIGHUP; settings marked
+// "requires restart" keep their startup values until the server is restarted.
+type Config struct {
+	// The grpc server port (requires restart).
+	GrpcPort int `yaml:"grpc_port"`
+	// The http port for Prometheus metrics, or 0 to disable (requires restart).
+	MetricsPort int `yaml:"metrics_port"`
+	// The http port for the web dashboard, or 0 to disable (requires restart).
+	DashboardPort int `yaml:"dashboard_port"`
+	// The http port for the web spectator, or 0 to disable (requires restart).
+	SpectatorPort int `yaml:"spectator_port"`
+	// The http port for the JSON/REST gateway, or 0 to disable (requires restart).
+	RestPort int `yaml:"rest_port"`
+	// Whether to enable verbose logging.
+	Verbose bool `yaml:"verbose"`
+	// The token required for admin requests. Admin requests are disabled if empty.
+	AdminToken string `yaml:"admin_token"`
+	// The minimum app version that clients should have to connect, sent to clients when they connect.
+	MinAppVersion string `yaml:"min_app_version"`
+
+	// Interval between running periodic cleanup checks.
+	PeriodicChecksInterval time.Duration `yaml:"periodic_checks_interval"`
+	// Timeout for removing a user who has not sent updates in this period.
+	UserTimeout time.Duration `yaml:"user_timeout"`
+	// Interval for periodic connection health pings from server to client.
+	ServerToClientPingInterval time.Duration `yaml:"server_to_client_ping_interval"`
+	// Time after which a grab lock expires unless the holder acquires it again.
+	GrabLockDuration time.Duration `yaml:"grab_lock_duration"`
+	// The maximum time to wait for clients to disconnect when shutting down.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// Optional address (host:port) sent to clients when shutting down for them to reconnect to.
+	ReconnectAddress string `yaml:"reconnect_address"`
+
+	// The default unit system for measurements, metric or imperial (requires restart).
+	MeasurementUnits string `yaml:"measurement_units"`
+	// The directory for storing uploaded 3D model files (requires restart).
+	AssetDir string `yaml:"asset_dir"`
+	// The maximum size in megabytes of an uploaded 3D model file.
+	MaxModelSizeMb int64 `yaml:"max_model_size_mb"`
+	// Optional directory of .glb and .gltf 3D model files to offer in the model catalog.
+	ModelCatalogDir string `yaml:"model_catalog_dir"`
+	// The maximum number of triangles drawn by a 3D model.
+	MaxModelTriangles int64 `yaml:"max_model_triangles"`
+	// The maximum width or height in pixels of each 3D model texture.
+	MaxModelTextureSize int `yaml:"max_model_texture_size"`
+
+	// Urls that are sent batches of user and content events as JSON POST requests.
+	WebhookUrls []string `yaml:"webhook_urls"`
+	// The secret for signing webhook requests with HMAC-SHA256. Requests are not signed if empty.
+	WebhookSecret string `yaml:"webhook_secret"`
+	// The maximum time to wait to collect a batch of events for each webhook.
+	WebhookBatchInterval time.Duration `yaml:"webhook_batch_interval"`
+	// The maximum number of events sent in each webhook request.
+	WebhookMaxBatchSize int `yaml:"webhook_max_batch_size"`
+	// The number of events queued for each webhook before further events are dropped (applies to new webhook urls).
+	WebhookQueueSize int `yaml:"webhook_queue_size"`
+	// The number of times a webhook request is sent before its events are dropped.
+	WebhookMaxAttempts int `yaml:"webhook_max_attempts"`
+}
+
+// LoadConfig builds the server configuration from the defaults, the config file at path if not empty, and then the
+// command line flags that were explicitly set.
+func LoadConfig(path string) (*Config, error) {
+	config := &Config{
+		MinAppVersion:              minAppVersion,
+		PeriodicChecksInterval:     defaultPeriodicChecksInterval,
+		UserTimeout:                defaultUserTimeout,
+		ServerToClientPingInterval: defaultServerToClientPingInterval,
+		GrabLockDuration:           defaultGrabLockDuration,
+		WebhookBatchInterval:       defaultWebhookBatchInterval,
+		WebhookMaxBatchSize:        defaultWebhookMaxBatchSize,
+		WebhookQueueSize:           defaultWebhookQueueSize,
+		WebhookMaxAttempts:         defaultWebhookMaxAttempts,
+	}
+	config.ApplyFlags(func(string) bool { return true })
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to parse config file %s: %v", path, err)
+		}
+	}
+
+	setFlags := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+	})
+	config.ApplyFlags(func(name string) bool { return setFlags[name] })
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// ApplyFlags sets each setting that has a command line flag from the flag's value, if isSet returns true for the flag
+// name.
+func (c *Config) ApplyFlags(isSet func(name string) bool) {
+	if isSet("grpc-port") {
+		c.GrpcPort = *grpcPort
+	}
+	if isSet("metrics-port") {
+		c.MetricsPort = *metricsPort
+	}
+	if isSet("dashboard-port") {
+		c.DashboardPort = *dashboardPort
+	}
+	if isSet("spectator-port") {
+		c.SpectatorPort = *spectatorPort
+	}
+	if isSet("rest-port") {
+		c.RestPort = *restPort
+	}
+	if isSet("verbose") {
+		c.Verbose = *verbose
+	}
+	if isSet("admin-token") {
+		c.AdminToken = *adminToken
+	}
+	if isSet("shutdown-timeout") {
+		c.ShutdownTimeout = *shutdownTimeout
+	}
+	if isSet("reconnect-address") {
+		c.ReconnectAddress = *reconnectAddress
+	}
+	if isSet("measurement-units") {
+		c.MeasurementUnits = *measurementUnits
+	}
+	if isSet("asset-dir") {
+		c.AssetDir = *assetDir
+	}
+	if isSet("max-model-size-mb") {
+		c.MaxModelSizeMb = *maxModelSizeMb
+	}
+	if isSet("model-catalog-dir") {
+		c.ModelCatalogDir = *modelCatalogDir
+	}
+	if isSet("max-model-triangles") {
+		c.MaxModelTriangles = *maxModelTriangles
+	}
+	if isSet("max-model-texture-size") {
+		c.MaxModelTextureSize = *maxModelTextureSize
+	}
+	if isSet("webhook-url") {
+		c.WebhookUrls = nil
+		for _, webhookUrl := range strings.Split(*webhookUrls, ",") {
+			if webhookUrl = strings.TrimSpace(webhookUrl); webhookUrl != "" {
+				c.WebhookUrls = append(c.WebhookUrls, webhookUrl)
+			}
+		}
+	}
+	if isSet("webhook-secret") {
+		c.WebhookSecret = *webhookSecret
+	}
+}
+
+// Validate checks that all settings are within their allowed ranges.
+func (c *Config) Validate() error {
+	if c.GrpcPort <= 0 || c.GrpcPort > 65535 {
+		return fmt.Errorf("invalid grpc_port %d", c.GrpcPort)
+	}
+	if c.MetricsPort < 0 || c.MetricsPort > 65535 {
+		return fmt.Errorf("invalid metrics_port %d", c.MetricsPort)
+	}
+	if c.DashboardPort < 0 || c.DashboardPort > 65535 {
+		return fmt.Errorf("invalid dashboard_port %d", c.DashboardPort)
+	}
+	if c.SpectatorPort < 0 || c.SpectatorPort > 65535 {
+		return fmt.Errorf("invalid spectator_port %d", c.SpectatorPort)
+	}
+	if c.RestPort < 0 || c.RestPort > 65535 {
+		return fmt.Errorf("invalid rest_port %d", c.RestPort)
+	}
+	if _, err := c.UnitSystem(); err != nil {
+		return err
+	}
+	for name, duration := range map[string]time.Duration{
+		"periodic_checks_interval":       c.PeriodicChecksInterval,
+		"user_timeout":                   c.UserTimeout,
+		"server_to_client_ping_interval": c.ServerToClientPingInterval,
+		"grab_lock_duration":             c.GrabLockDuration,
+		"shutdown_timeout":               c.ShutdownTimeout,
+		"webhook_batch_interval":         c.WebhookBatchInterval,
+	} {
+		if duration <= 0 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+	}
+	if c.AssetDir == "" {
+		return fmt.Errorf("asset_dir must be set")
+	}
+	if c.MaxModelSizeMb <= 0 || c.MaxModelTriangles <= 0 || c.MaxModelTextureSize <= 0 {
+		return fmt.Errorf("3D model limits must be positive")
+	}
+	if c.WebhookMaxBatchSize <= 0 || c.WebhookQueueSize <= 0 || c.WebhookMaxAttempts <= 0 {
+		return fmt.Errorf("webhook batch size, queue size and attempts must be positive")
+	}
+	for _, webhookUrl := range c.WebhookUrls {
+		parsed, err := url.Parse(webhookUrl)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid webhook url %q", webhookUrl)
+		}
+	}
+	return nil
+}
+
+// UnitSystem returns the default unit system for measurements.
+func (c *Config) UnitSystem() (pb.RoomSettingsProto_UnitSystem, error) {
+	unitSystem, ok := pb.RoomSettingsProto_UnitSystem_value[strings.ToUpper(c.MeasurementUnits)]
+	if !ok {
+		return 0, fmt.Errorf("unknown measurement units: %v", c.MeasurementUnits)
+	}
+	return pb.RoomSettingsProto_UnitSystem(unitSystem), nil
+}
+
+// ModelBudgets returns the limits that every 3D model must be within.
+func (c *Config) ModelBudgets() GltfBudgets {
+	return GltfBudgets{MaxTriangleCount: c.MaxModelTriangles, MaxTextureSize: c.MaxModelTextureSize}
+}
+
+// KeepRestartRequiredSettings resets the settings that only take effect after a restart to their values in the
+// running configuration, and returns the names of the settings that were changed.
+func (c *Config) KeepRestartRequiredSettings(running *Config) []string {
+	var changes []string
+	if c.GrpcPort != running.GrpcPort {
+		changes = append(changes, "grpc_port")
+		c.GrpcPort = running.GrpcPort
+	}
+	if c.MetricsPort != running.MetricsPort {
+		changes = append(changes, "metrics_port")
+		c.MetricsPort = running.MetricsPort
+	}
+	if c.DashboardPort != running.DashboardPort {
+		changes = append(changes, "dashboard_port")
+		c.DashboardPort = running.DashboardPort
+	}
+	if c.SpectatorPort != running.SpectatorPort {
+		changes = append(changes, "spectator_port")
+		c.SpectatorPort = running.SpectatorPort
+	}
+	if c.RestPort != running.RestPort {
+		changes = append(changes, "rest_port")
+		c.RestPort = running.RestPort
+	}
+	if !strings.EqualFold(c.MeasurementUnits, running.MeasurementUnits) {
+		changes = append(changes, "measurement_units")
+		c.MeasurementUnits = running.MeasurementUnits
+	}
+	if c.AssetDir != running.AssetDir {
+		changes = append(changes, "asset_dir")
+		c.AssetDir = running.AssetDir
+	}
+	return changes
+}
+
+// Config returns the server's current configuration, which must not be modified. s.lock does not need to be held
+// while calling this function.
+func (s *Server) Config() *Config {
+	return s.config.Load().(*Config)
+}
+
+// ApplyConfig changes the server's configuration, applying the settings that can change while the server is running.
+func (s *Server) ApplyConfig(config *Config) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.verbose = config.Verbose
+	s.adminToken = config.AdminToken
+	s.config.Store(config)
+	s.assetStore.SetLimits(config.MaxModelSizeMb*1024*1024, config.ModelBudgets())
+	s.assetStore.SetCatalogDir(config.ModelCatalogDir)
+}
+
+// ReloadConfig loads the config file at path again and applies the settings that can change while the server is
+// running. The running configuration is kept if the file is invalid.
+func (s *Server) ReloadConfig(path string) {
+	if path == "" {
+		log.Printf("*** Warning: not reloading config, no config file is set")
+		return
+	}
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		log.Printf("*** Failed to reload config: %v", err)
+		return
+	}
+	for _, name := range config.KeepRestartRequiredSettings(s.Config()) {
+		log.Printf("*** Warning: the change to %s in %s requires a restart", name, path)
+	}
+	s.ApplyConfig(config)
+	log.Printf("Reloaded config from %s", path)
+}
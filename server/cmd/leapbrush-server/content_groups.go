@@ -0,0 +1,312 @@
+package main
+
+import (
+	"log"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+// HandleContentGroupAddLocked handles a group that was added or replaced by a user. s.lock must be held while calling
+// this function.
+func (s *Server) HandleContentGroupAddLocked(userName string, req *pb.ContentGroupAddRequest, echo bool) {
+	if req.Group == nil {
+		return
+	}
+	anchorState, ok := s.anchorStateMap[req.Group.AnchorId]
+	if !ok {
+		return
+	}
+
+	group := proto.Clone(req.Group).(*pb.ContentGroupProto)
+	group.ModifiedByUserName = userName
+	s.SetContentGroupLocked(anchorState, group, userName, echo)
+
+	if s.verbose {
+		log.Printf("User %s: Create or replace group %s (%v) for anchor %s, %d members",
+			userName, group.Id, group.Name, anchorState.id, len(ContentGroupMemberIds(group)))
+	}
+}
+
+// HandleContentGroupUpdateLocked handles a partial update to an existing group from a user. s.lock must be held while
+// calling this function.
+func (s *Server) HandleContentGroupUpdateLocked(userName string, req *pb.ContentGroupUpdateRequest, echo bool) {
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok {
+		return
+	}
+	existingGroup, ok := anchorState.contentGroups[req.Id]
+	if !ok {
+		log.Printf("User %s: *** Warning: ignoring update for unknown group %s", userName, req.Id)
+		return
+	}
+
+	group := proto.Clone(existingGroup).(*pb.ContentGroupProto)
+	group.ModifiedByUserName = userName
+	if req.Name != nil {
+		group.Name = *req.Name
+	}
+	if req.Transform != nil {
+		group.Transform = req.Transform
+	}
+	if req.Hidden != nil {
+		group.Hidden = *req.Hidden
+	}
+	group.BrushStrokeIds = UpdateIdList(group.BrushStrokeIds, req.AddBrushStrokeIds, req.RemoveBrushStrokeIds)
+	group.ExternalModelIds = UpdateIdList(group.ExternalModelIds, req.AddExternalModelIds, req.RemoveExternalModelIds)
+	group.TextNoteIds = UpdateIdList(group.TextNoteIds, req.AddTextNoteIds, req.RemoveTextNoteIds)
+	group.PrimitiveIds = UpdateIdList(group.PrimitiveIds, req.AddPrimitiveIds, req.RemovePrimitiveIds)
+	group.MeasurementIds = UpdateIdList(group.MeasurementIds, req.AddMeasurementIds, req.RemoveMeasurementIds)
+
+	s.SetContentGroupLocked(anchorState, group, userName, echo)
+
+	if s.verbose {
+		log.Printf("User %s: Updated group %s (%v) for anchor %s, %d members",
+			userName, group.Id, group.Name, anchorState.id, len(ContentGroupMemberIds(group)))
+	}
+}
+
+// HandleContentGroupRemoveLocked handles a group that was removed by a user, optionally along with all of its
+// members. s.lock must be held while calling this function.
+func (s *Server) HandleContentGroupRemoveLocked(userName string, req *pb.ContentGroupRemoveRequest, echo bool) {
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok {
+		return
+	}
+	group, ok := anchorState.contentGroups[req.Id]
+	if !ok {
+		return
+	}
+
+	s.RemoveContentGroupLocked(anchorState, req.Id, userName, echo)
+
+	// Members in locked layers are left in place.
+	if req.RemoveMembers {
+		for _, brushStrokeId := range group.BrushStrokeIds {
+			if !s.RejectLockedBrushStrokeEditLocked(anchorState, brushStrokeId, "", userName) {
+				s.RemoveBrushStrokeLocked(anchorState, brushStrokeId, userName, echo)
+			}
+		}
+		for _, modelId := range group.ExternalModelIds {
+			if !s.RejectLockedExternalModelEditLocked(anchorState, modelId, "", userName) {
+				s.RemoveExternalModelLocked(anchorState, modelId, userName, echo)
+			}
+		}
+		for _, textNoteId := range group.TextNoteIds {
+			if !s.RejectLockedTextNoteEditLocked(anchorState, textNoteId, "", userName) {
+				s.RemoveTextNoteLocked(anchorState, textNoteId, userName, echo)
+			}
+		}
+		for _, primitiveId := range group.PrimitiveIds {
+			if !s.RejectLockedPrimitiveEditLocked(anchorState, primitiveId, "", userName) {
+				s.RemovePrimitiveLocked(anchorState, primitiveId, userName, echo)
+			}
+		}
+		for _, measurementId := range group.MeasurementIds {
+			if !s.RejectLockedMeasurementEditLocked(anchorState, measurementId, "", userName) {
+				s.RemoveMeasurementLocked(anchorState, measurementId, userName, echo)
+			}
+		}
+	}
+
+	if s.verbose {
+		log.Printf("User %s: Removed group %s from anchor %s (members removed: %v)",
+			userName, req.Id, anchorState.id, req.RemoveMembers)
+	}
+}
+
+// SetContentGroupLocked stores a new or replaced group and distributes it to other users. Member ids that don't
+// exist on the anchor are dropped, and members are removed from any other group they belonged to. The group proto
+// must not be modified after calling this function. s.lock must be held while calling this function.
+func (s *Server) SetContentGroupLocked(anchorState *AnchorState, group *pb.ContentGroupProto, senderUserName string, echo bool) {
+	var brushStrokeIds []string
+	for _, brushStrokeId := range UpdateIdList(nil, group.BrushStrokeIds, nil) {
+		if _, ok := anchorState.brushStrokes[brushStrokeId]; ok {
+			brushStrokeIds = append(brushStrokeIds, brushStrokeId)
+		}
+	}
+	var modelIds []string
+	for _, modelId := range UpdateIdList(nil, group.ExternalModelIds, nil) {
+		if _, ok := anchorState.externalModels[modelId]; ok {
+			modelIds = append(modelIds, modelId)
+		}
+	}
+	var textNoteIds []string
+	for _, textNoteId := range UpdateIdList(nil, group.TextNoteIds, nil) {
+		if _, ok := anchorState.textNotes[textNoteId]; ok {
+			textNoteIds = append(textNoteIds, textNoteId)
+		}
+	}
+	var primitiveIds []string
+	for _, primitiveId := range UpdateIdList(nil, group.PrimitiveIds, nil) {
+		if _, ok := anchorState.primitives[primitiveId]; ok {
+			primitiveIds = append(primitiveIds, primitiveId)
+		}
+	}
+	var measurementIds []string
+	for _, measurementId := range UpdateIdList(nil, group.MeasurementIds, nil) {
+		if _, ok := anchorState.measurements[measurementId]; ok {
+			measurementIds = append(measurementIds, measurementId)
+		}
+	}
+	group.BrushStrokeIds = brushStrokeIds
+	group.ExternalModelIds = modelIds
+	group.TextNoteIds = textNoteIds
+	group.PrimitiveIds = primitiveIds
+	group.MeasurementIds = measurementIds
+
+	// Clear the membership index for the previous version of this group, then move each member out of any other group.
+	if existingGroup, ok := anchorState.contentGroups[group.Id]; ok {
+		for _, contentId := range ContentGroupMemberIds(existingGroup) {
+			delete(anchorState.contentGroupIds, contentId)
+		}
+	}
+	for _, contentId := range ContentGroupMemberIds(group) {
+		s.RemoveContentFromGroupLocked(anchorState, contentId, senderUserName, echo)
+		anchorState.contentGroupIds[contentId] = group.Id
+	}
+
+	anchorState.contentGroups[group.Id] = group
+	s.DistributeContentGroupAddLocked(anchorState, group.Id, senderUserName, echo)
+}
+
+// ContentGroupTransform returns the transform of the group that a brush stroke, 3D model, text note, primitive or
+// measurement belongs to, or nil if it doesn't belong to a group.
+func (a *AnchorState) ContentGroupTransform(contentId string) *pb.TransformProto {
+	group, ok := a.contentGroups[a.contentGroupIds[contentId]]
+	if !ok {
+		return nil
+	}
+	return group.Transform
+}
+
+// RemoveContentGroupLocked removes a group (but not its members) from an anchor and distributes the removal to other
+// users. s.lock must be held while calling this function.
+func (s *Server) RemoveContentGroupLocked(anchorState *AnchorState, groupId string, senderUserName string, echo bool) {
+	group, ok := anchorState.contentGroups[groupId]
+	if !ok {
+		return
+	}
+
+	for _, contentId := range ContentGroupMemberIds(group) {
+		delete(anchorState.contentGroupIds, contentId)
+	}
+	delete(anchorState.contentGroups, groupId)
+
+	s.DistributeContentGroupRemoveLocked(anchorState, groupId, senderUserName, echo)
+}
+
+// RemoveContentFromGroupLocked removes a brush stroke, 3d model, text note, primitive or measurement from the group it
+// belongs to, if any, and distributes the updated group to other users. s.lock must be held while calling this
+// function.
+func (s *Server) RemoveContentFromGroupLocked(anchorState *AnchorState, contentId string, senderUserName string, echo bool) {
+	groupId, ok := anchorState.contentGroupIds[contentId]
+	if !ok {
+		return
+	}
+	delete(anchorState.contentGroupIds, contentId)
+
+	existingGroup, ok := anchorState.contentGroups[groupId]
+	if !ok {
+		return
+	}
+
+	group := proto.Clone(existingGroup).(*pb.ContentGroupProto)
+	group.BrushStrokeIds = UpdateIdList(group.BrushStrokeIds, nil, []string{contentId})
+	group.ExternalModelIds = UpdateIdList(group.ExternalModelIds, nil, []string{contentId})
+	group.TextNoteIds = UpdateIdList(group.TextNoteIds, nil, []string{contentId})
+	group.PrimitiveIds = UpdateIdList(group.PrimitiveIds, nil, []string{contentId})
+	group.MeasurementIds = UpdateIdList(group.MeasurementIds, nil, []string{contentId})
+	anchorState.contentGroups[groupId] = group
+
+	s.DistributeContentGroupAddLocked(anchorState, groupId, senderUserName, echo)
+}
+
+// DistributeContentGroupAddLocked sets notification bits for user connections, for a group that was added or
+// modified. s.lock must be held while calling this function.
+func (s *Server) DistributeContentGroupAddLocked(anchorState *AnchorState, groupId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutContentGroupRemovals, groupId)
+			userConnectionEntry.notifyAboutContentGroupAdds[groupId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeContentGroupRemoveLocked sets notification bits for user connections, for a group that was removed.
+// s.lock must be held while calling this function.
+func (s *Server) DistributeContentGroupRemoveLocked(anchorState *AnchorState, groupId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutContentGroupAdds, groupId)
+			userConnectionEntry.notifyAboutContentGroupRemovals[groupId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeMissingContentGroupsToUserLocked sets notify bits for all groups that a user hasn't been notified about
+// yet. s.lock must be held while calling this function.
+func (s *Server) DistributeMissingContentGroupsToUserLocked(userStateEntry *UserState, userConnectionState *UserConnectionState) {
+	if userStateEntry == nil {
+		userStateEntry = s.userStateMap[userConnectionState.userName]
+	}
+	if userConnectionState == nil {
+		userConnectionState = s.userConnectionsMap[userStateEntry.userName]
+	}
+	if userStateEntry == nil || userConnectionState == nil || userStateEntry.spaceInfoProto == nil {
+		return
+	}
+
+	for _, anchor := range userStateEntry.spaceInfoProto.Anchor {
+		if anchorState, ok := s.anchorStateMap[anchor.Id]; ok {
+			for groupId := range anchorState.contentGroups {
+				userConnectionState.notifyAboutContentGroupAdds[groupId] = anchor.Id
+			}
+		}
+	}
+}
+
+// ContentGroupMemberIds returns the ids of all members of a group.
+func ContentGroupMemberIds(group *pb.ContentGroupProto) []string {
+	var ids []string
+	ids = append(ids, group.BrushStrokeIds...)
+	ids = append(ids, group.ExternalModelIds...)
+	ids = append(ids, group.TextNoteIds...)
+	ids = append(ids, group.PrimitiveIds...)
+	ids = append(ids, group.MeasurementIds...)
+	return ids
+}
+
+// UpdateIdList returns a copy of ids with addIds appended and removeIds removed, without duplicates.
+func UpdateIdList(ids []string, addIds []string, removeIds []string) []string {
+	removeSet := make(map[string]bool)
+	for _, id := range removeIds {
+		removeSet[id] = true
+	}
+
+	var result []string
+	for _, id := range append(append([]string{}, ids...), addIds...) {
+		if !removeSet[id] {
+			result = append(result, id)
+			removeSet[id] = true
+		}
+	}
+	return result
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Battery level at or below which a headset that is not charging is considered low.
+	lowBatteryLevel = 20
+)
+
+// IsLowBattery returns whether a battery is at or below lowBatteryLevel and not being charged.
+func IsLowBattery(battery *pb.BatteryStatusProto) bool {
+	return battery.Level <= lowBatteryLevel && battery.State != pb.BatteryStatusProto_CHARGING &&
+		battery.State != pb.BatteryStatusProto_FULL
+}
+
+// The dashboard page, which polls the status endpoint to refresh itself.
+//
+//go:embed dashboard.html
+var dashboardHtml []byte
+
+// DashboardUser is the dashboard status of a user that has a listening stream or has sent updates and not timed out.
+type DashboardUser struct {
+	UserName        string   `json:"userName"`
+	DisplayName     string   `json:"displayName"`
+	DeviceType      string   `json:"deviceType"`
+	AppVersion      string   `json:"appVersion"`
+	Listening       bool     `json:"listening"`
+	UpdateLagMillis int64    `json:"updateLagMillis"`
+	BatteryLevel    *uint32  `json:"batteryLevel"`
+	BatteryState    string   `json:"batteryState"`
+	LowBattery      bool     `json:"lowBattery"`
+	SpaceName       string   `json:"spaceName"`
+	AnchorIds       []string `json:"anchorIds"`
+}
+
+// DashboardAnchor is the dashboard status of an anchor and the content attached to it.
+type DashboardAnchor struct {
+	Id                 string   `json:"id"`
+	UserNames          []string `json:"userNames"`
+	BrushStrokeCount   int      `json:"brushStrokeCount"`
+	ExternalModelCount int      `json:"externalModelCount"`
+	TextNoteCount      int      `json:"textNoteCount"`
+	PrimitiveCount     int      `json:"primitiveCount"`
+	MeasurementCount   int      `json:"measurementCount"`
+	ContentGroupCount  int      `json:"contentGroupCount"`
+	CommentCount       int      `json:"commentCount"`
+}
+
+// DashboardStatus is the response of the dashboard status endpoint.
+type DashboardStatus struct {
+	ServerVersion    string            `json:"serverVersion"`
+	ServerTimeMillis int64             `json:"serverTimeMillis"`
+	Users            []DashboardUser   `json:"users"`
+	Anchors          []DashboardAnchor `json:"anchors"`
+}
+
+// ServeDashboard handles an HTTP request for the dashboard page.
+func ServeDashboard(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(dashboardHtml)
+}
+
+// ServeDashboardStatus handles an HTTP request for the connected users and anchors shown on the dashboard, as JSON.
+func (s *Server) ServeDashboardStatus(w http.ResponseWriter, _ *http.Request) {
+	status := s.BuildDashboardStatus()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		log.Printf("*** Warning: failed to send dashboard status: %v", err)
+	}
+}
+
+// BuildDashboardStatus returns the current users and anchors, sorted by name. The status is built from the same
+// lists as the ListConnections and ListAnchors admin rpcs.
+func (s *Server) BuildDashboardStatus() *DashboardStatus {
+	connections, anchors := func() (*pb.ListConnectionsResponse, *pb.ListAnchorsResponse) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		return s.BuildConnectionListLocked(), s.BuildAnchorListLocked()
+	}()
+
+	status := &DashboardStatus{
+		ServerVersion:    serverVersion,
+		ServerTimeMillis: time.Now().UnixMilli(),
+		Users:            []DashboardUser{},
+		Anchors:          []DashboardAnchor{},
+	}
+
+	for _, connection := range connections.Connections {
+		user := DashboardUser{
+			UserName:        connection.UserName,
+			DisplayName:     connection.UserDisplayName,
+			AppVersion:      connection.AppVersion,
+			Listening:       connection.Listening,
+			UpdateLagMillis: connection.UpdateLagMillis,
+			SpaceName:       connection.SpaceName,
+			AnchorIds:       append([]string{}, connection.AnchorIds...),
+		}
+		if connection.DeviceType != nil {
+			user.DeviceType = connection.DeviceType.String()
+		}
+		if battery := connection.HeadsetBattery; battery != nil {
+			level := battery.Level
+			user.BatteryLevel = &level
+			user.BatteryState = battery.State.String()
+			user.LowBattery = IsLowBattery(battery)
+		}
+		status.Users = append(status.Users, user)
+	}
+
+	for _, anchor := range anchors.Anchors {
+		status.Anchors = append(status.Anchors, DashboardAnchor{
+			Id:                 anchor.Id,
+			UserNames:          append([]string{}, anchor.UserNames...),
+			BrushStrokeCount:   int(anchor.BrushStrokeCount),
+			ExternalModelCount: int(anchor.ExternalModelCount),
+			TextNoteCount:      int(anchor.TextNoteCount),
+			PrimitiveCount:     int(anchor.PrimitiveCount),
+			MeasurementCount:   int(anchor.MeasurementCount),
+			ContentGroupCount:  int(anchor.ContentGroupCount),
+			CommentCount:       int(anchor.CommentCount),
+		})
+	}
+
+	return status
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Number of events buffered for each subscriber before further events are dropped.
+	eventSubscriberBufferSize = 1024
+)
+
+// EventSubscriber receives the user and content events published by the server.
+type EventSubscriber struct {
+	// A name identifying the subscriber for logging.
+	name string
+	// The channel that events are sent to. Events are dropped if the channel is full.
+	events chan *pb.ServerEventProto
+	// Number of events dropped since the last dropped events warning. Protected by s.lock.
+	numDropped int
+}
+
+// SubscribeEvents registers a new subscriber for server events, which must be unsubscribed once it is no longer
+// reading events. The subscriber's channel is closed when the server shuts down.
+func (s *Server) SubscribeEvents(name string) *EventSubscriber {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	subscriber := &EventSubscriber{name: name, events: make(chan *pb.ServerEventProto, eventSubscriberBufferSize)}
+	if s.shutDown {
+		close(subscriber.events)
+	} else {
+		s.eventSubscribers[subscriber] = true
+	}
+	return subscriber
+}
+
+// UnsubscribeEvents stops sending server events to a subscriber.
+func (s *Server) UnsubscribeEvents(subscriber *EventSubscriber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.eventSubscribers, subscriber)
+}
+
+// CloseEventSubscribersLocked closes the channels of all subscribers and removes them. s.lock must be held while
+// calling this function.
+func (s *Server) CloseEventSubscribersLocked() {
+	for subscriber := range s.eventSubscribers {
+		close(subscriber.events)
+	}
+	s.eventSubscribers = make(map[*EventSubscriber]bool)
+}
+
+// PublishEventLocked sends an event to every subscriber without blocking. s.lock must be held while calling this
+// function.
+func (s *Server) PublishEventLocked(eventType pb.ServerEventProto_Type, userName string, anchorId string, contentId string, detail string) {
+	if len(s.eventSubscribers) == 0 {
+		return
+	}
+
+	event := &pb.ServerEventProto{
+		Type:       eventType,
+		TimeMillis: time.Now().UnixMilli(),
+		UserName:   userName,
+		AnchorId:   anchorId,
+		ContentId:  contentId,
+		Detail:     detail,
+	}
+	for subscriber := range s.eventSubscribers {
+		select {
+		case subscriber.events <- event:
+			if subscriber.numDropped > 0 {
+				log.Printf("*** Warning: dropped %d events for slow subscriber %s",
+					subscriber.numDropped, subscriber.name)
+				subscriber.numDropped = 0
+			}
+		default:
+			subscriber.numDropped++
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Maximum absolute playback rate for a 3D model animation.
+	maxAnimationPlaybackRate = 16
+
+	// Maximum length in bytes of a 3D model animation clip name.
+	maxAnimationClipNameLength = 256
+)
+
+// HandleExternalModelAddLocked handles a 3d model that was added or modified by a user. s.lock must be held while
+// calling this function.
+func (s *Server) HandleExternalModelAddLocked(userName string, req *pb.ExternalModelAddRequest, echo bool) {
+	if req.Model == nil {
+		return
+	}
+	anchorState, ok := s.anchorStateMap[req.Model.AnchorId]
+	if !ok {
+		return
+	}
+	existingModel := anchorState.externalModels[req.Model.Id]
+	if req.Model.ParentId == nil {
+		// Updates from users that don't support attaching models, or that only move a model, keep the existing
+		// parent, since the transform is relative to it.
+		req.Model.ParentId = proto.String(existingModel.GetParentId())
+	}
+	if s.RejectLockedExternalModelEditLocked(anchorState, req.Model.Id, req.Model.LayerId, userName) ||
+		s.RejectInvalidContentParentLocked(anchorState, req.Model.Id, req.Model.GetParentId(), false, userName) {
+		return
+	}
+	if contentHash := req.Model.ContentHash; contentHash != "" {
+		if _, ok := s.assetStore.Get(contentHash); !ok {
+			log.Printf("User %s: *** Warning: model %s references unknown content hash %s",
+				userName, req.Model.Id, contentHash)
+		}
+	}
+
+	model := req.Model
+	var existingAnimation *pb.ModelAnimationProto
+	if existingModel != nil {
+		// Layer membership is only changed by SetContentLayer, so that users moving a model don't take it out of its
+		// layer.
+		model.LayerId = existingModel.LayerId
+		existingAnimation = existingModel.Animation
+	}
+	if model.Animation == nil {
+		// Updates from users that don't support animations keep the existing animation.
+		model.Animation = existingAnimation
+	} else if animation, err := UpdateModelAnimation(existingAnimation, model.Animation, time.Now()); err != nil {
+		log.Printf("User %s: *** Warning: ignoring invalid animation for model %s: %v", userName, model.Id, err)
+		model.Animation = existingAnimation
+	} else {
+		model.Animation = animation
+	}
+
+	if _, ok := anchorState.contentInfo[model.Id]; !ok {
+		anchorState.contentInfo[model.Id] = &ContentInfo{createdByUserName: userName, createTime: time.Now()}
+		s.PublishEventLocked(pb.ServerEventProto_EXTERNAL_MODEL_ADDED, userName, anchorState.id, model.Id,
+			model.FileName)
+	}
+	anchorState.externalModels[model.Id] = model
+
+	// The sender is sent the model when the server sets the animation time, so it has the server's value.
+	animationChanged := model.Animation != existingAnimation
+	s.DistributeExternalModelAddLocked(anchorState, model.Id, userName, echo || animationChanged)
+	if s.verbose {
+		log.Printf("User %s: Create or update model %s (%v) for anchor %s",
+			userName, model.Id, model.FileName, anchorState.id)
+	}
+}
+
+// UpdateModelAnimation returns the animation state to store for a 3d model when a user sends newAnimation. Only an
+// animation with a zero playback time changes the animation state, with the playback time set to now. Otherwise the
+// existing animation is returned unchanged, so that users passing through an old animation state while updating the
+// transform don't move the animation for everyone.
+func UpdateModelAnimation(existingAnimation *pb.ModelAnimationProto, newAnimation *pb.ModelAnimationProto, now time.Time) (*pb.ModelAnimationProto, error) {
+	if err := ValidateModelAnimation(newAnimation); err != nil {
+		return nil, err
+	}
+	if existingAnimation != nil && newAnimation.PositionServerTimeMillis != 0 {
+		return existingAnimation, nil
+	}
+
+	animation := proto.Clone(newAnimation).(*pb.ModelAnimationProto)
+	animation.PositionServerTimeMillis = now.UnixMilli()
+	return animation, nil
+}
+
+// ValidateModelAnimation checks that a 3d model animation has a finite playback rate and position within range.
+func ValidateModelAnimation(animation *pb.ModelAnimationProto) error {
+	if len(animation.ClipName) > maxAnimationClipNameLength {
+		return fmt.Errorf("clip name length %d > %d", len(animation.ClipName), maxAnimationClipNameLength)
+	}
+	playbackRate := float64(animation.PlaybackRate)
+	if math.IsNaN(playbackRate) || math.Abs(playbackRate) > maxAnimationPlaybackRate {
+		return fmt.Errorf("playback rate %v is out of range", animation.PlaybackRate)
+	}
+	positionSeconds := float64(animation.PositionSeconds)
+	if math.IsNaN(positionSeconds) || math.IsInf(positionSeconds, 0) || positionSeconds < 0 {
+		return fmt.Errorf("position %v is out of range", animation.PositionSeconds)
+	}
+	return nil
+}
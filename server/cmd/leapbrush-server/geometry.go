@@ -0,0 +1,283 @@
+package main
+
+import (
+	"math"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+// Vec3 is a simple 3 component vector used for server side geometry calculations.
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+// Vec3FromProto converts a Vector3Proto to a Vec3. A nil proto is treated as the zero vector.
+func Vec3FromProto(v *pb.Vector3Proto) Vec3 {
+	if v == nil {
+		return Vec3{}
+	}
+	return Vec3{float64(v.X), float64(v.Y), float64(v.Z)}
+}
+
+// Vec3ToProto converts a Vec3 to a Vector3Proto.
+func Vec3ToProto(a Vec3) *pb.Vector3Proto {
+	return &pb.Vector3Proto{X: float32(a.X), Y: float32(a.Y), Z: float32(a.Z)}
+}
+
+func (a Vec3) Add(b Vec3) Vec3 {
+	return Vec3{a.X + b.X, a.Y + b.Y, a.Z + b.Z}
+}
+
+func (a Vec3) Sub(b Vec3) Vec3 {
+	return Vec3{a.X - b.X, a.Y - b.Y, a.Z - b.Z}
+}
+
+func (a Vec3) Scale(f float64) Vec3 {
+	return Vec3{a.X * f, a.Y * f, a.Z * f}
+}
+
+func (a Vec3) Dot(b Vec3) float64 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z
+}
+
+func (a Vec3) Length() float64 {
+	return math.Sqrt(a.Dot(a))
+}
+
+func (a Vec3) Cross(b Vec3) Vec3 {
+	return Vec3{a.Y*b.Z - a.Z*b.Y, a.Z*b.X - a.X*b.Z, a.X*b.Y - a.Y*b.X}
+}
+
+// IsFinite checks that no component of this vector is NaN or infinite.
+func (a Vec3) IsFinite() bool {
+	for _, f := range []float64{a.X, a.Y, a.Z} {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// Quat is a simple quaternion used for server side geometry calculations.
+type Quat struct {
+	X, Y, Z, W float64
+}
+
+// QuatFromProto converts a QuaternionProto to a Quat. A nil or zero proto is treated as the identity rotation.
+func QuatFromProto(q *pb.QuaternionProto) Quat {
+	if q == nil || (q.X == 0 && q.Y == 0 && q.Z == 0 && q.W == 0) {
+		return Quat{W: 1}
+	}
+	return Quat{float64(q.X), float64(q.Y), float64(q.Z), float64(q.W)}
+}
+
+func (q Quat) Conjugate() Quat {
+	return Quat{-q.X, -q.Y, -q.Z, q.W}
+}
+
+// Mul returns the rotation of r followed by the rotation of q.
+func (q Quat) Mul(r Quat) Quat {
+	return Quat{
+		q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+		q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+	}
+}
+
+// QuatToProto converts a Quat to a QuaternionProto.
+func QuatToProto(q Quat) *pb.QuaternionProto {
+	return &pb.QuaternionProto{X: float32(q.X), Y: float32(q.Y), Z: float32(q.Z), W: float32(q.W)}
+}
+
+// Rotate rotates the vector v by this quaternion.
+func (q Quat) Rotate(v Vec3) Vec3 {
+	u := Vec3{q.X, q.Y, q.Z}
+	t := Vec3{u.Y*v.Z - u.Z*v.Y, u.Z*v.X - u.X*v.Z, u.X*v.Y - u.Y*v.X}.Scale(2)
+	return v.Add(t.Scale(q.W)).Add(Vec3{u.Y*t.Z - u.Z*t.Y, u.Z*t.X - u.X*t.Z, u.X*t.Y - u.Y*t.X})
+}
+
+// DistanceToSegment returns the distance from point p to the line segment between a and b.
+func DistanceToSegment(p Vec3, a Vec3, b Vec3) float64 {
+	ab := b.Sub(a)
+	lengthSquared := ab.Dot(ab)
+	if lengthSquared == 0 {
+		return p.Sub(a).Length()
+	}
+	t := math.Max(0, math.Min(1, p.Sub(a).Dot(ab)/lengthSquared))
+	return p.Sub(a.Add(ab.Scale(t))).Length()
+}
+
+// RegionContains checks whether a point relative to the region's anchor is inside the region described by an
+// EraseRegionRequest.
+func RegionContains(region *pb.EraseRegionRequest, p Vec3) bool {
+	radius := float64(region.Radius)
+
+	switch region.Shape {
+	case pb.EraseRegionRequest_SPHERE:
+		for _, pose := range region.Path {
+			if p.Sub(Vec3FromProto(pose.Position)).Length() <= radius {
+				return true
+			}
+		}
+	case pb.EraseRegionRequest_BOX:
+		halfExtents := Vec3FromProto(region.BoxHalfExtents)
+		for _, pose := range region.Path {
+			local := QuatFromProto(pose.Rotation).Conjugate().Rotate(p.Sub(Vec3FromProto(pose.Position)))
+			if math.Abs(local.X) <= halfExtents.X && math.Abs(local.Y) <= halfExtents.Y &&
+				math.Abs(local.Z) <= halfExtents.Z {
+				return true
+			}
+		}
+	case pb.EraseRegionRequest_CAPSULE:
+		if len(region.Path) == 1 {
+			return p.Sub(Vec3FromProto(region.Path[0].Position)).Length() <= radius
+		}
+		for i := 1; i < len(region.Path); i++ {
+			if DistanceToSegment(p, Vec3FromProto(region.Path[i-1].Position),
+				Vec3FromProto(region.Path[i].Position)) <= radius {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// DistanceBetweenSegments returns the shortest distance between the line segment from a0 to a1 and the line segment
+// from b0 to b1.
+func DistanceBetweenSegments(a0 Vec3, a1 Vec3, b0 Vec3, b1 Vec3) float64 {
+	da := a1.Sub(a0)
+	db := b1.Sub(b0)
+	lengthSquaredA := da.Dot(da)
+	lengthSquaredB := db.Dot(db)
+	if lengthSquaredA == 0 {
+		return DistanceToSegment(a0, b0, b1)
+	}
+	if lengthSquaredB == 0 {
+		return DistanceToSegment(b0, a0, a1)
+	}
+
+	// Find the closest points a0 + da*s and b0 + db*t, first on the infinite lines and then clamped to the segments.
+	r := a0.Sub(b0)
+	dotAB := da.Dot(db)
+	dotAR := da.Dot(r)
+	dotBR := db.Dot(r)
+	s := 0.0
+	if denom := lengthSquaredA*lengthSquaredB - dotAB*dotAB; denom > 0 {
+		s = math.Max(0, math.Min(1, (dotAB*dotBR-dotAR*lengthSquaredB)/denom))
+	}
+	t := (dotAB*s + dotBR) / lengthSquaredB
+	if t < 0 {
+		t = 0
+		s = math.Max(0, math.Min(1, -dotAR/lengthSquaredA))
+	} else if t > 1 {
+		t = 1
+		s = math.Max(0, math.Min(1, (dotAB-dotAR)/lengthSquaredA))
+	}
+	return a0.Add(da.Scale(s)).Sub(b0.Add(db.Scale(t))).Length()
+}
+
+// SegmentIntersectsBox checks whether the line segment from a to b intersects the axis aligned box centered on the
+// origin with the given half extents.
+func SegmentIntersectsBox(a Vec3, b Vec3, halfExtents Vec3) bool {
+	start := [3]float64{a.X, a.Y, a.Z}
+	delta := [3]float64{b.X - a.X, b.Y - a.Y, b.Z - a.Z}
+	extents := [3]float64{halfExtents.X, halfExtents.Y, halfExtents.Z}
+
+	// Clip the segment's parameter range [0, 1] to the slab between the box faces along each axis.
+	tMin, tMax := 0.0, 1.0
+	for i := 0; i < 3; i++ {
+		if delta[i] == 0 {
+			if math.Abs(start[i]) > extents[i] {
+				return false
+			}
+			continue
+		}
+		t0 := (-extents[i] - start[i]) / delta[i]
+		t1 := (extents[i] - start[i]) / delta[i]
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+		tMin = math.Max(tMin, t0)
+		tMax = math.Min(tMax, t1)
+		if tMin > tMax {
+			return false
+		}
+	}
+	return true
+}
+
+// RegionIntersectsSegment checks whether any part of the line segment from a to b, relative to the region's anchor,
+// is inside the region described by an EraseRegionRequest.
+func RegionIntersectsSegment(region *pb.EraseRegionRequest, a Vec3, b Vec3) bool {
+	radius := float64(region.Radius)
+
+	switch region.Shape {
+	case pb.EraseRegionRequest_SPHERE:
+		for _, pose := range region.Path {
+			if DistanceToSegment(Vec3FromProto(pose.Position), a, b) <= radius {
+				return true
+			}
+		}
+	case pb.EraseRegionRequest_BOX:
+		halfExtents := Vec3FromProto(region.BoxHalfExtents)
+		for _, pose := range region.Path {
+			toLocal := QuatFromProto(pose.Rotation).Conjugate()
+			position := Vec3FromProto(pose.Position)
+			if SegmentIntersectsBox(toLocal.Rotate(a.Sub(position)), toLocal.Rotate(b.Sub(position)), halfExtents) {
+				return true
+			}
+		}
+	case pb.EraseRegionRequest_CAPSULE:
+		if len(region.Path) == 1 {
+			return DistanceToSegment(Vec3FromProto(region.Path[0].Position), a, b) <= radius
+		}
+		for i := 1; i < len(region.Path); i++ {
+			if DistanceBetweenSegments(a, b, Vec3FromProto(region.Path[i-1].Position),
+				Vec3FromProto(region.Path[i].Position)) <= radius {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// TransformPoint applies a transform (scale, then rotation, then translation) to a point. A nil transform is treated
+// as the identity transform.
+func TransformPoint(transform *pb.TransformProto, p Vec3) Vec3 {
+	if transform == nil {
+		return p
+	}
+	if transform.Scale != nil {
+		p = Vec3{p.X * float64(transform.Scale.X), p.Y * float64(transform.Scale.Y), p.Z * float64(transform.Scale.Z)}
+	}
+	return QuatFromProto(transform.Rotation).Rotate(p).Add(Vec3FromProto(transform.Position))
+}
+
+// ComposeTransforms returns a transform equivalent to applying child and then parent. Nil transforms are treated as
+// the identity transform. Scales are multiplied per axis, which is exact unless the parent has a non-uniform scale and
+// the child is rotated relative to it.
+func ComposeTransforms(parent *pb.TransformProto, child *pb.TransformProto) *pb.TransformProto {
+	if parent == nil {
+		return child
+	}
+	if child == nil {
+		return parent
+	}
+
+	result := &pb.TransformProto{
+		Position: Vec3ToProto(TransformPoint(parent, Vec3FromProto(child.Position))),
+		Rotation: QuatToProto(QuatFromProto(parent.Rotation).Mul(QuatFromProto(child.Rotation))),
+	}
+	if parent.Scale != nil && child.Scale != nil {
+		result.Scale = &pb.Vector3Proto{
+			X: parent.Scale.X * child.Scale.X, Y: parent.Scale.Y * child.Scale.Y, Z: parent.Scale.Z * child.Scale.Z}
+	} else if parent.Scale != nil {
+		result.Scale = parent.Scale
+	} else {
+		result.Scale = child.Scale
+	}
+	return result
+}
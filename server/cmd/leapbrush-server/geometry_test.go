@@ -0,0 +1,129 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+// testPose returns a path pose at a position with the identity rotation.
+func testPose(x, y, z float32) *pb.PoseProto {
+	return &pb.PoseProto{Position: &pb.Vector3Proto{X: x, Y: y, Z: z}, Rotation: &pb.QuaternionProto{W: 1}}
+}
+
+func TestDistanceBetweenSegments(t *testing.T) {
+	tests := []struct {
+		name           string
+		a0, a1, b0, b1 Vec3
+		want           float64
+	}{
+		{name: "crossing", a0: Vec3{-1, 0, 0}, a1: Vec3{1, 0, 0}, b0: Vec3{0, -1, 0}, b1: Vec3{0, 1, 0}, want: 0},
+		{name: "skew", a0: Vec3{-1, 0, 0}, a1: Vec3{1, 0, 0}, b0: Vec3{0, -1, 1}, b1: Vec3{0, 1, 1}, want: 1},
+		{name: "skew closest at endpoints", a0: Vec3{0, 0, 0}, a1: Vec3{1, 0, 0}, b0: Vec3{2, -1, 1},
+			b1: Vec3{2, 1, 1}, want: math.Sqrt2},
+		{name: "parallel overlapping", a0: Vec3{0, 0, 0}, a1: Vec3{2, 0, 0}, b0: Vec3{1, 1, 0}, b1: Vec3{3, 1, 0},
+			want: 1},
+		{name: "parallel opposite directions", a0: Vec3{0, 0, 0}, a1: Vec3{2, 0, 0}, b0: Vec3{3, 1, 0},
+			b1: Vec3{1, 1, 0}, want: 1},
+		{name: "parallel disjoint", a0: Vec3{0, 0, 0}, a1: Vec3{1, 0, 0}, b0: Vec3{2, 1, 0}, b1: Vec3{3, 1, 0},
+			want: math.Sqrt2},
+		{name: "collinear disjoint", a0: Vec3{0, 0, 0}, a1: Vec3{1, 0, 0}, b0: Vec3{3, 0, 0}, b1: Vec3{4, 0, 0},
+			want: 2},
+		{name: "first degenerate", a0: Vec3{0, 1, 0}, a1: Vec3{0, 1, 0}, b0: Vec3{-1, 0, 0}, b1: Vec3{1, 0, 0},
+			want: 1},
+		{name: "second degenerate", a0: Vec3{-1, 0, 0}, a1: Vec3{1, 0, 0}, b0: Vec3{2, 1, 0}, b1: Vec3{2, 1, 0},
+			want: math.Sqrt2},
+		{name: "both degenerate", a0: Vec3{0, 0, 0}, a1: Vec3{0, 0, 0}, b0: Vec3{3, 4, 0}, b1: Vec3{3, 4, 0}, want: 5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := DistanceBetweenSegments(test.a0, test.a1, test.b0, test.b1); math.Abs(got-test.want) > 1e-9 {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+			if got := DistanceBetweenSegments(test.b0, test.b1, test.a0, test.a1); math.Abs(got-test.want) > 1e-9 {
+				t.Errorf("with segments swapped got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSegmentIntersectsBox(t *testing.T) {
+	halfExtents := Vec3{1, 1, 1}
+	tests := []struct {
+		name string
+		a, b Vec3
+		want bool
+	}{
+		{name: "fully inside", a: Vec3{-0.5, 0, 0}, b: Vec3{0.5, 0.5, 0.5}, want: true},
+		{name: "fully outside", a: Vec3{2, 2, 2}, b: Vec3{3, 3, 3}, want: false},
+		{name: "through", a: Vec3{-5, 0, 0}, b: Vec3{5, 0, 0}, want: true},
+		{name: "one end inside", a: Vec3{0, 0, 0}, b: Vec3{5, 5, 5}, want: true},
+		{name: "grazes face", a: Vec3{-5, 1, 0}, b: Vec3{5, 1, 0}, want: true},
+		{name: "misses face", a: Vec3{-5, 1.001, 0}, b: Vec3{5, 1.001, 0}, want: false},
+		{name: "grazes edge", a: Vec3{2, 0, 0}, b: Vec3{0, 2, 0}, want: true},
+		{name: "misses edge", a: Vec3{2.01, 0, 0}, b: Vec3{0, 2.01, 0}, want: false},
+		{name: "ends before box", a: Vec3{5, 0, 0}, b: Vec3{1.5, 0, 0}, want: false},
+		{name: "degenerate inside", a: Vec3{0.5, 0.5, 0.5}, b: Vec3{0.5, 0.5, 0.5}, want: true},
+		{name: "degenerate outside", a: Vec3{1.5, 0, 0}, b: Vec3{1.5, 0, 0}, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := SegmentIntersectsBox(test.a, test.b, halfExtents); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+			if got := SegmentIntersectsBox(test.b, test.a, halfExtents); got != test.want {
+				t.Errorf("with ends swapped got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRegionIntersectsSegment(t *testing.T) {
+	sphere := &pb.EraseRegionRequest{Shape: pb.EraseRegionRequest_SPHERE, Radius: 1,
+		Path: []*pb.PoseProto{testPose(0, 0, 0), testPose(10, 0, 0)}}
+	// A unit box at x = 10, rotated 45 degrees around the z axis so that its corners reach x = 10 +/- sqrt(2).
+	box := &pb.EraseRegionRequest{Shape: pb.EraseRegionRequest_BOX, BoxHalfExtents: &pb.Vector3Proto{X: 1, Y: 1, Z: 1},
+		Path: []*pb.PoseProto{{Position: &pb.Vector3Proto{X: 10},
+			Rotation: &pb.QuaternionProto{Z: float32(math.Sin(math.Pi / 8)), W: float32(math.Cos(math.Pi / 8))}}}}
+	capsule := &pb.EraseRegionRequest{Shape: pb.EraseRegionRequest_CAPSULE, Radius: 0.5,
+		Path: []*pb.PoseProto{testPose(0, 0, 0), testPose(0, 0, 10)}}
+	pointCapsule := &pb.EraseRegionRequest{Shape: pb.EraseRegionRequest_CAPSULE, Radius: 0.5,
+		Path: []*pb.PoseProto{testPose(0, 0, 0)}}
+
+	tests := []struct {
+		name   string
+		region *pb.EraseRegionRequest
+		a, b   Vec3
+		want   bool
+	}{
+		{name: "sphere fully inside", region: sphere, a: Vec3{-0.5, 0, 0}, b: Vec3{0.5, 0, 0}, want: true},
+		{name: "sphere fully outside", region: sphere, a: Vec3{2, 2, 0}, b: Vec3{3, 3, 0}, want: false},
+		{name: "sphere crossed", region: sphere, a: Vec3{-5, 0.5, 0}, b: Vec3{5, 0.5, 0}, want: true},
+		{name: "sphere grazed", region: sphere, a: Vec3{-5, 1, 0}, b: Vec3{5, 1, 0}, want: true},
+		{name: "sphere missed", region: sphere, a: Vec3{-5, 1.01, 0}, b: Vec3{5, 1.01, 0}, want: false},
+		{name: "second sphere crossed", region: sphere, a: Vec3{10, -5, 0}, b: Vec3{10, 5, 0}, want: true},
+		{name: "box fully inside", region: box, a: Vec3{9.9, 0, 0}, b: Vec3{10.1, 0, 0}, want: true},
+		{name: "box fully outside", region: box, a: Vec3{20, -5, 0}, b: Vec3{20, 5, 0}, want: false},
+		{name: "rotated box corner crossed", region: box, a: Vec3{11.3, -5, 0}, b: Vec3{11.3, 5, 0}, want: true},
+		{name: "rotated box corner missed", region: box, a: Vec3{11.5, -5, 0}, b: Vec3{11.5, 5, 0}, want: false},
+		{name: "capsule crossed", region: capsule, a: Vec3{-1, 0.4, 5}, b: Vec3{1, 0.4, 5}, want: true},
+		{name: "capsule parallel inside", region: capsule, a: Vec3{0.4, 0, 1}, b: Vec3{0.4, 0, 9}, want: true},
+		{name: "capsule parallel outside", region: capsule, a: Vec3{0.6, 0, 0}, b: Vec3{0.6, 0, 10}, want: false},
+		{name: "capsule past end", region: capsule, a: Vec3{-1, 0, 10.6}, b: Vec3{1, 0, 10.6}, want: false},
+		{name: "single pose capsule crossed", region: pointCapsule, a: Vec3{-1, 0.4, 0}, b: Vec3{1, 0.4, 0},
+			want: true},
+		{name: "single pose capsule missed", region: pointCapsule, a: Vec3{-1, 0.6, 0}, b: Vec3{1, 0.6, 0},
+			want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := RegionIntersectsSegment(test.region, test.a, test.b); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
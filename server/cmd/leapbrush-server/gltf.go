@@ -0,0 +1,722 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// GLB chunk types.
+	glbChunkTypeJson = 0x4E4F534A
+	glbChunkTypeBin  = 0x004E4942
+
+	// Maximum depth of the node hierarchy in a glTF scene.
+	maxGltfNodeDepth = 64
+
+	// Maximum number of problems reported for an invalid glTF model.
+	maxGltfValidationProblems = 20
+
+	// Maximum element count of a glTF accessor, which keeps triangle counts and byte ranges far from overflowing.
+	maxGltfAccessorCount = 1 << 32
+)
+
+// ktx2Identifier is the file identifier at the start of every KTX2 texture.
+var ktx2Identifier = []byte{0xAB, 0x4B, 0x54, 0x58, 0x20, 0x32, 0x30, 0xBB, 0x0D, 0x0A, 0x1A, 0x0A}
+
+// gltfAccessorComponentCounts contains the number of components for each glTF accessor type.
+var gltfAccessorComponentCounts = map[string]int{
+	"SCALAR": 1, "VEC2": 2, "VEC3": 3, "VEC4": 4, "MAT2": 4, "MAT3": 9, "MAT4": 16,
+}
+
+// gltfComponentTypeSizes contains the size in bytes of each glTF accessor component type.
+var gltfComponentTypeSizes = map[int]int64{
+	5120: 1, 5121: 1, 5122: 2, 5123: 2, 5125: 4, 5126: 4,
+}
+
+// GltfBudgets contains the limits that a glTF model must be within to be distributed to devices.
+type GltfBudgets struct {
+	// Maximum number of triangles drawn by the default scene.
+	MaxTriangleCount int64
+	// Maximum width or height in pixels of each texture image.
+	MaxTextureSize int
+}
+
+// GltfValidationError contains every problem found when validating a glTF model.
+type GltfValidationError struct {
+	Problems []string
+}
+
+func (e *GltfValidationError) Error() string {
+	if len(e.Problems) == 1 {
+		return e.Problems[0]
+	}
+	return fmt.Sprintf("%d problems: %s", len(e.Problems), strings.Join(e.Problems, "; "))
+}
+
+// GltfDocument contains the parts of a glTF json document that the server inspects.
+type GltfDocument struct {
+	Asset struct {
+		Version string          `json:"version"`
+		Extras  json.RawMessage `json:"extras"`
+	} `json:"asset"`
+	Scene  *int `json:"scene"`
+	Scenes []struct {
+		Nodes []int `json:"nodes"`
+	} `json:"scenes"`
+	Nodes []struct {
+		Mesh        *int      `json:"mesh"`
+		Children    []int     `json:"children"`
+		Matrix      []float64 `json:"matrix"`
+		Translation []float64 `json:"translation"`
+		Rotation    []float64 `json:"rotation"`
+		Scale       []float64 `json:"scale"`
+	} `json:"nodes"`
+	Meshes []struct {
+		Primitives []struct {
+			Attributes map[string]int `json:"attributes"`
+			Indices    *int           `json:"indices"`
+			Mode       *int           `json:"mode"`
+		} `json:"primitives"`
+	} `json:"meshes"`
+	Accessors []struct {
+		BufferView    *int      `json:"bufferView"`
+		ByteOffset    int64     `json:"byteOffset"`
+		ComponentType int       `json:"componentType"`
+		Count         int64     `json:"count"`
+		Type          string    `json:"type"`
+		Min           []float64 `json:"min"`
+		Max           []float64 `json:"max"`
+	} `json:"accessors"`
+	Buffers []struct {
+		Uri        string `json:"uri"`
+		ByteLength int64  `json:"byteLength"`
+	} `json:"buffers"`
+	BufferViews []struct {
+		Buffer     int   `json:"buffer"`
+		ByteOffset int64 `json:"byteOffset"`
+		ByteLength int64 `json:"byteLength"`
+		ByteStride int64 `json:"byteStride"`
+	} `json:"bufferViews"`
+	Images []struct {
+		Uri        string `json:"uri"`
+		BufferView *int   `json:"bufferView"`
+		MimeType   string `json:"mimeType"`
+	} `json:"images"`
+	Textures []struct {
+		Source *int `json:"source"`
+	} `json:"textures"`
+}
+
+// GltfModelInfo contains metadata computed from a glTF model.
+type GltfModelInfo struct {
+	// The title from the asset extras, if any.
+	Title string
+	// The tags from the asset extras, if any.
+	Tags []string
+	// The number of triangles drawn by the default scene.
+	TriangleCount int64
+	// The axis aligned bounding box of the default scene. Both are zero if the scene has no meshes.
+	BoundsMin, BoundsMax Vec3
+}
+
+// gltfMatrix is a 4x4 column-major transform matrix as used by glTF.
+type gltfMatrix [16]float64
+
+var gltfIdentityMatrix = gltfMatrix{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1}
+
+func (m gltfMatrix) Mul(o gltfMatrix) gltfMatrix {
+	var r gltfMatrix
+	for col := 0; col < 4; col++ {
+		for row := 0; row < 4; row++ {
+			var sum float64
+			for k := 0; k < 4; k++ {
+				sum += m[k*4+row] * o[col*4+k]
+			}
+			r[col*4+row] = sum
+		}
+	}
+	return r
+}
+
+func (m gltfMatrix) TransformPoint(p Vec3) Vec3 {
+	return Vec3{
+		m[0]*p.X + m[4]*p.Y + m[8]*p.Z + m[12],
+		m[1]*p.X + m[5]*p.Y + m[9]*p.Z + m[13],
+		m[2]*p.X + m[6]*p.Y + m[10]*p.Z + m[14],
+	}
+}
+
+// LoadGltf parses and validates the data of a .glb or .gltf model file, and computes its metadata.
+func LoadGltf(data []byte, fileName string, budgets GltfBudgets) (*GltfModelInfo, error) {
+	doc, binChunk, err := ParseGltf(data, fileName)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateGltf(doc, binChunk, budgets); err != nil {
+		return nil, err
+	}
+	modelInfo, err := AnalyzeGltf(doc)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckGltfBudgets(modelInfo, budgets); err != nil {
+		return nil, err
+	}
+	return modelInfo, nil
+}
+
+// ParseGltf parses a .glb or .gltf model file, returning the json document and the GLB binary chunk, if any.
+func ParseGltf(data []byte, fileName string) (*GltfDocument, []byte, error) {
+	jsonData := data
+	var binChunk []byte
+	if strings.ToLower(filepath.Ext(fileName)) == ".glb" {
+		var err error
+		if jsonData, binChunk, err = parseGlbChunks(data); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	doc := &GltfDocument{}
+	if err := json.Unmarshal(jsonData, doc); err != nil {
+		return nil, nil, fmt.Errorf("invalid glTF json: %v", err)
+	}
+	if !strings.HasPrefix(doc.Asset.Version, "2.") {
+		return nil, nil, fmt.Errorf("unsupported glTF version %q", doc.Asset.Version)
+	}
+	return doc, binChunk, nil
+}
+
+// parseGlbChunks splits a GLB file into its json chunk and optional binary chunk.
+func parseGlbChunks(data []byte) ([]byte, []byte, error) {
+	if len(data) < 12 || string(data[0:4]) != "glTF" {
+		return nil, nil, fmt.Errorf("missing GLB header")
+	}
+	if version := binary.LittleEndian.Uint32(data[4:8]); version != 2 {
+		return nil, nil, fmt.Errorf("unsupported GLB version %d", version)
+	}
+	if length := binary.LittleEndian.Uint32(data[8:12]); int64(length) != int64(len(data)) {
+		return nil, nil, fmt.Errorf("GLB header length %d does not match file size %d", length, len(data))
+	}
+
+	var jsonChunk, binChunk []byte
+	for offset, i := 12, 0; offset < len(data); i++ {
+		if len(data)-offset < 8 {
+			return nil, nil, fmt.Errorf("truncated GLB chunk header at offset %d", offset)
+		}
+		chunkLength := int64(binary.LittleEndian.Uint32(data[offset : offset+4]))
+		chunkType := binary.LittleEndian.Uint32(data[offset+4 : offset+8])
+		offset += 8
+		if chunkLength > int64(len(data)-offset) {
+			return nil, nil, fmt.Errorf("GLB chunk %d length %d exceeds file size", i, chunkLength)
+		}
+		chunk := data[offset : offset+int(chunkLength)]
+		offset += int(chunkLength)
+
+		switch {
+		case i == 0 && chunkType == glbChunkTypeJson:
+			jsonChunk = chunk
+		case i == 0:
+			return nil, nil, fmt.Errorf("first GLB chunk must be json")
+		case i == 1 && chunkType == glbChunkTypeBin:
+			binChunk = chunk
+		}
+	}
+	if jsonChunk == nil {
+		return nil, nil, fmt.Errorf("missing GLB json chunk")
+	}
+	return jsonChunk, binChunk, nil
+}
+
+// AnalyzeGltf computes the title, tags, triangle count and bounding box of a glTF document.
+func AnalyzeGltf(doc *GltfDocument) (*GltfModelInfo, error) {
+	info := &GltfModelInfo{}
+
+	if len(doc.Asset.Extras) > 0 {
+		var extras struct {
+			Title string          `json:"title"`
+			Tags  json.RawMessage `json:"tags"`
+		}
+		// Extras may be any json value, so extras that aren't an object are ignored.
+		if json.Unmarshal(doc.Asset.Extras, &extras) == nil {
+			info.Title = extras.Title
+			info.Tags = parseGltfTags(extras.Tags)
+		}
+	}
+
+	rootNodes, err := gltfSceneRootNodes(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	hasBounds := false
+	var visit func(nodeIndex int, parentMatrix gltfMatrix, depth int) error
+	visit = func(nodeIndex int, parentMatrix gltfMatrix, depth int) error {
+		if nodeIndex < 0 || nodeIndex >= len(doc.Nodes) {
+			return fmt.Errorf("node %d out of range", nodeIndex)
+		}
+		if depth > maxGltfNodeDepth {
+			return fmt.Errorf("node hierarchy deeper than %d, or contains a cycle", maxGltfNodeDepth)
+		}
+		node := doc.Nodes[nodeIndex]
+		matrix, err := gltfNodeMatrix(nodeIndex, node.Matrix, node.Translation, node.Rotation, node.Scale)
+		if err != nil {
+			return err
+		}
+		matrix = parentMatrix.Mul(matrix)
+
+		if node.Mesh != nil {
+			if *node.Mesh < 0 || *node.Mesh >= len(doc.Meshes) {
+				return fmt.Errorf("mesh %d out of range", *node.Mesh)
+			}
+			for _, primitive := range doc.Meshes[*node.Mesh].Primitives {
+				triangles, err := gltfPrimitiveTriangleCount(doc, primitive.Attributes, primitive.Indices,
+					primitive.Mode)
+				if err != nil {
+					return err
+				}
+				info.TriangleCount += triangles
+
+				positionIndex, ok := primitive.Attributes["POSITION"]
+				if !ok {
+					continue
+				}
+				accessor := doc.Accessors[positionIndex]
+				if len(accessor.Min) != 3 || len(accessor.Max) != 3 {
+					continue
+				}
+				for corner := 0; corner < 8; corner++ {
+					p := Vec3{accessor.Min[0], accessor.Min[1], accessor.Min[2]}
+					if corner&1 != 0 {
+						p.X = accessor.Max[0]
+					}
+					if corner&2 != 0 {
+						p.Y = accessor.Max[1]
+					}
+					if corner&4 != 0 {
+						p.Z = accessor.Max[2]
+					}
+					p = matrix.TransformPoint(p)
+					if !hasBounds {
+						info.BoundsMin, info.BoundsMax = p, p
+						hasBounds = true
+						continue
+					}
+					info.BoundsMin = Vec3{math.Min(info.BoundsMin.X, p.X), math.Min(info.BoundsMin.Y, p.Y),
+						math.Min(info.BoundsMin.Z, p.Z)}
+					info.BoundsMax = Vec3{math.Max(info.BoundsMax.X, p.X), math.Max(info.BoundsMax.Y, p.Y),
+						math.Max(info.BoundsMax.Z, p.Z)}
+				}
+			}
+		}
+
+		for _, child := range node.Children {
+			if err := visit(child, matrix, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, nodeIndex := range rootNodes {
+		if err := visit(nodeIndex, gltfIdentityMatrix, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// parseGltfTags parses tags from asset extras, which may be a list of strings or a single comma separated string.
+func parseGltfTags(raw json.RawMessage) []string {
+	var tags []string
+	if json.Unmarshal(raw, &tags) != nil {
+		var tagString string
+		if json.Unmarshal(raw, &tagString) != nil {
+			return nil
+		}
+		tags = strings.Split(tagString, ",")
+	}
+
+	var result []string
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// gltfSceneRootNodes returns the root nodes of the default scene, or of every node hierarchy if the document has no
+// scenes.
+func gltfSceneRootNodes(doc *GltfDocument) ([]int, error) {
+	if len(doc.Scenes) > 0 {
+		scene := 0
+		if doc.Scene != nil {
+			scene = *doc.Scene
+		}
+		if scene < 0 || scene >= len(doc.Scenes) {
+			return nil, fmt.Errorf("scene %d out of range", scene)
+		}
+		return doc.Scenes[scene].Nodes, nil
+	}
+
+	isChild := make(map[int]bool)
+	for _, node := range doc.Nodes {
+		for _, child := range node.Children {
+			isChild[child] = true
+		}
+	}
+	var rootNodes []int
+	for i := range doc.Nodes {
+		if !isChild[i] {
+			rootNodes = append(rootNodes, i)
+		}
+	}
+	return rootNodes, nil
+}
+
+// gltfNodeMatrix returns the local transform of a node from either its matrix or its translation, rotation and scale.
+func gltfNodeMatrix(nodeIndex int, matrix []float64, translation []float64, rotation []float64, scale []float64) (gltfMatrix, error) {
+	if matrix != nil {
+		if len(matrix) != 16 {
+			return gltfMatrix{}, fmt.Errorf("node %d matrix must have 16 elements", nodeIndex)
+		}
+		var m gltfMatrix
+		copy(m[:], matrix)
+		return m, nil
+	}
+
+	t := Vec3{}
+	if translation != nil {
+		if len(translation) != 3 {
+			return gltfMatrix{}, fmt.Errorf("node %d translation must have 3 elements", nodeIndex)
+		}
+		t = Vec3{translation[0], translation[1], translation[2]}
+	}
+	r := Quat{W: 1}
+	if rotation != nil {
+		if len(rotation) != 4 {
+			return gltfMatrix{}, fmt.Errorf("node %d rotation must have 4 elements", nodeIndex)
+		}
+		r = Quat{rotation[0], rotation[1], rotation[2], rotation[3]}
+	}
+	s := Vec3{1, 1, 1}
+	if scale != nil {
+		if len(scale) != 3 {
+			return gltfMatrix{}, fmt.Errorf("node %d scale must have 3 elements", nodeIndex)
+		}
+		s = Vec3{scale[0], scale[1], scale[2]}
+	}
+
+	x := r.Rotate(Vec3{s.X, 0, 0})
+	y := r.Rotate(Vec3{0, s.Y, 0})
+	z := r.Rotate(Vec3{0, 0, s.Z})
+	return gltfMatrix{x.X, x.Y, x.Z, 0, y.X, y.Y, y.Z, 0, z.X, z.Y, z.Z, 0, t.X, t.Y, t.Z, 1}, nil
+}
+
+// gltfPrimitiveTriangleCount returns the number of triangles drawn by a mesh primitive. Point and line primitives
+// draw no triangles.
+func gltfPrimitiveTriangleCount(doc *GltfDocument, attributes map[string]int, indices *int, mode *int) (int64, error) {
+	for name, accessorIndex := range attributes {
+		if accessorIndex < 0 || accessorIndex >= len(doc.Accessors) {
+			return 0, fmt.Errorf("attribute %s accessor %d out of range", name, accessorIndex)
+		}
+	}
+	var vertexCount int64
+	if indices != nil {
+		if *indices < 0 || *indices >= len(doc.Accessors) {
+			return 0, fmt.Errorf("indices accessor %d out of range", *indices)
+		}
+		vertexCount = doc.Accessors[*indices].Count
+	} else if positionIndex, ok := attributes["POSITION"]; ok {
+		vertexCount = doc.Accessors[positionIndex].Count
+	}
+
+	// Triangles is the default mode.
+	drawMode := 4
+	if mode != nil {
+		drawMode = *mode
+	}
+	switch drawMode {
+	case 4:
+		return vertexCount / 3, nil
+	case 5, 6:
+		if vertexCount < 3 {
+			return 0, nil
+		}
+		return vertexCount - 2, nil
+	}
+	return 0, nil
+}
+
+// ValidateGltf checks that a glTF document only references data inside the file, that all of its indices and byte
+// ranges are valid, and that its textures are within budget. The triangle budget is checked by CheckGltfBudgets, after
+// the document is analyzed. Returns a GltfValidationError listing the problems found.
+func ValidateGltf(doc *GltfDocument, binChunk []byte, budgets GltfBudgets) error {
+	var problems []string
+	addProblem := func(format string, args ...interface{}) {
+		if len(problems) < maxGltfValidationProblems {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	// Buffers must be embedded, and must be large enough for their declared length.
+	bufferData := make([][]byte, len(doc.Buffers))
+	for i, buffer := range doc.Buffers {
+		switch {
+		case buffer.Uri == "" && i == 0 && binChunk != nil:
+			bufferData[i] = binChunk
+		case buffer.Uri == "":
+			addProblem("buffer %d has no data", i)
+			continue
+		case isGltfDataUri(buffer.Uri):
+			data, err := decodeGltfDataUri(buffer.Uri)
+			if err != nil {
+				addProblem("buffer %d: %v", i, err)
+				continue
+			}
+			bufferData[i] = data
+		default:
+			addProblem("buffer %d references external uri %q", i, truncateString(buffer.Uri, 64))
+			continue
+		}
+		if buffer.ByteLength < 0 || buffer.ByteLength > int64(len(bufferData[i])) {
+			addProblem("buffer %d byteLength %d exceeds its data length %d", i, buffer.ByteLength,
+				len(bufferData[i]))
+			bufferData[i] = nil
+		}
+	}
+
+	for i, bufferView := range doc.BufferViews {
+		if bufferView.Buffer < 0 || bufferView.Buffer >= len(doc.Buffers) {
+			addProblem("bufferView %d buffer %d out of range", i, bufferView.Buffer)
+			continue
+		}
+		if bufferView.ByteLength <= 0 || !gltfRangeWithin(bufferView.ByteOffset, bufferView.ByteLength,
+			doc.Buffers[bufferView.Buffer].ByteLength) {
+			addProblem("bufferView %d range exceeds buffer %d", i, bufferView.Buffer)
+		}
+	}
+
+	for i, accessor := range doc.Accessors {
+		componentCount, ok := gltfAccessorComponentCounts[accessor.Type]
+		if !ok {
+			addProblem("accessor %d has unknown type %q", i, accessor.Type)
+			continue
+		}
+		componentSize, ok := gltfComponentTypeSizes[accessor.ComponentType]
+		if !ok {
+			addProblem("accessor %d has unknown componentType %d", i, accessor.ComponentType)
+			continue
+		}
+		if accessor.Count < 0 || accessor.Count > maxGltfAccessorCount {
+			addProblem("accessor %d count %d out of range", i, accessor.Count)
+			continue
+		}
+		if accessor.BufferView == nil {
+			continue
+		}
+		if *accessor.BufferView < 0 || *accessor.BufferView >= len(doc.BufferViews) {
+			addProblem("accessor %d bufferView %d out of range", i, *accessor.BufferView)
+			continue
+		}
+		bufferView := doc.BufferViews[*accessor.BufferView]
+		elementSize := int64(componentCount) * componentSize
+		stride := elementSize
+		if bufferView.ByteStride > 0 {
+			stride = bufferView.ByteStride
+		}
+		if accessor.Count > 0 && !gltfStridedRangeWithin(accessor.ByteOffset, stride, accessor.Count, elementSize,
+			bufferView.ByteLength) {
+			addProblem("accessor %d range exceeds bufferView %d", i, *accessor.BufferView)
+		}
+	}
+
+	for i, mesh := range doc.Meshes {
+		for j, primitive := range mesh.Primitives {
+			for name, accessorIndex := range primitive.Attributes {
+				if accessorIndex < 0 || accessorIndex >= len(doc.Accessors) {
+					addProblem("mesh %d primitive %d attribute %s accessor %d out of range", i, j, name,
+						accessorIndex)
+				}
+			}
+			if primitive.Indices != nil && (*primitive.Indices < 0 || *primitive.Indices >= len(doc.Accessors)) {
+				addProblem("mesh %d primitive %d indices accessor %d out of range", i, j, *primitive.Indices)
+			}
+		}
+	}
+
+	// Every node may have at most one parent, which also rules out cycles.
+	parents := make(map[int]int)
+	for i, node := range doc.Nodes {
+		if node.Mesh != nil && (*node.Mesh < 0 || *node.Mesh >= len(doc.Meshes)) {
+			addProblem("node %d mesh %d out of range", i, *node.Mesh)
+		}
+		for _, child := range node.Children {
+			if child < 0 || child >= len(doc.Nodes) {
+				addProblem("node %d child %d out of range", i, child)
+			} else if child == i {
+				addProblem("node %d is its own child", i)
+			} else if parent, ok := parents[child]; ok {
+				addProblem("node %d has more than one parent (%d and %d)", child, parent, i)
+			} else {
+				parents[child] = i
+			}
+		}
+	}
+	for i, scene := range doc.Scenes {
+		for _, node := range scene.Nodes {
+			if node < 0 || node >= len(doc.Nodes) {
+				addProblem("scene %d node %d out of range", i, node)
+			}
+		}
+	}
+
+	for i, texture := range doc.Textures {
+		if texture.Source != nil && (*texture.Source < 0 || *texture.Source >= len(doc.Images)) {
+			addProblem("texture %d source %d out of range", i, *texture.Source)
+		}
+	}
+
+	// Images must be embedded, in a format with a readable size that is within budget.
+	for i, image := range doc.Images {
+		var data []byte
+		switch {
+		case image.BufferView != nil:
+			if *image.BufferView < 0 || *image.BufferView >= len(doc.BufferViews) {
+				addProblem("image %d bufferView %d out of range", i, *image.BufferView)
+				continue
+			}
+			bufferView := doc.BufferViews[*image.BufferView]
+			if bufferView.Buffer < 0 || bufferView.Buffer >= len(bufferData) {
+				continue
+			}
+			buffer := bufferData[bufferView.Buffer]
+			if buffer == nil || !gltfRangeWithin(bufferView.ByteOffset, bufferView.ByteLength, int64(len(buffer))) {
+				continue
+			}
+			data = buffer[bufferView.ByteOffset : bufferView.ByteOffset+bufferView.ByteLength]
+		case isGltfDataUri(image.Uri):
+			var err error
+			if data, err = decodeGltfDataUri(image.Uri); err != nil {
+				addProblem("image %d: %v", i, err)
+				continue
+			}
+		case image.Uri != "":
+			addProblem("image %d references external uri %q", i, truncateString(image.Uri, 64))
+			continue
+		default:
+			addProblem("image %d has no data", i)
+			continue
+		}
+
+		width, height, err := gltfImageSize(data)
+		if err != nil {
+			addProblem("image %d: %v", i, err)
+		} else if width > budgets.MaxTextureSize || height > budgets.MaxTextureSize {
+			addProblem("image %d size %dx%d exceeds the texture budget of %dx%d", i, width, height,
+				budgets.MaxTextureSize, budgets.MaxTextureSize)
+		}
+	}
+
+	if len(problems) > 0 {
+		return &GltfValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// gltfRangeWithin returns whether the byte range of length bytes at offset lies within size bytes, without
+// overflowing for untrusted values.
+func gltfRangeWithin(offset int64, length int64, size int64) bool {
+	return offset >= 0 && length >= 0 && offset <= size && length <= size-offset
+}
+
+// gltfStridedRangeWithin returns whether count elements of elementSize bytes, starting at offset and stride bytes apart,
+// lie within size bytes, without overflowing for untrusted values. count must be positive.
+func gltfStridedRangeWithin(offset int64, stride int64, count int64, elementSize int64, size int64) bool {
+	if stride <= 0 || elementSize <= 0 || !gltfRangeWithin(offset, elementSize, size) {
+		return false
+	}
+	// The last element starts at offset+stride*(count-1), which must leave room for elementSize bytes.
+	return count-1 <= (size-offset-elementSize)/stride
+}
+
+// CheckGltfBudgets checks that an analyzed glTF model is within the triangle budget.
+func CheckGltfBudgets(info *GltfModelInfo, budgets GltfBudgets) error {
+	if info.TriangleCount > budgets.MaxTriangleCount {
+		return &GltfValidationError{Problems: []string{fmt.Sprintf(
+			"%d triangles exceeds the triangle budget of %d", info.TriangleCount, budgets.MaxTriangleCount)}}
+	}
+	return nil
+}
+
+// isGltfDataUri checks whether a uri embeds its data rather than referencing an external file.
+func isGltfDataUri(uri string) bool {
+	return strings.HasPrefix(uri, "data:")
+}
+
+// decodeGltfDataUri decodes the data in a base64 data uri.
+func decodeGltfDataUri(uri string) ([]byte, error) {
+	separator := strings.Index(uri, ";base64,")
+	if separator < 0 {
+		return nil, fmt.Errorf("data uri is not base64 encoded")
+	}
+	data, err := base64.StdEncoding.DecodeString(uri[separator+len(";base64,"):])
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 in data uri: %v", err)
+	}
+	return data, nil
+}
+
+// gltfImageSize reads the width and height from the header of a PNG, JPEG or KTX2 image.
+func gltfImageSize(data []byte) (int, int, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		if len(data) < 24 || string(data[12:16]) != "IHDR" {
+			return 0, 0, fmt.Errorf("truncated PNG header")
+		}
+		return int(binary.BigEndian.Uint32(data[16:20])), int(binary.BigEndian.Uint32(data[20:24])), nil
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8}):
+		// Walk the JPEG segments to the first start of frame segment, which contains the size.
+		for offset := 2; offset+4 <= len(data); {
+			if data[offset] != 0xFF {
+				return 0, 0, fmt.Errorf("invalid JPEG segment")
+			}
+			marker := data[offset+1]
+			if marker == 0xFF {
+				offset++
+				continue
+			}
+			segmentLength := int(binary.BigEndian.Uint16(data[offset+2 : offset+4]))
+			isStartOfFrame := marker >= 0xC0 && marker <= 0xCF && marker != 0xC4 && marker != 0xC8 && marker != 0xCC
+			if isStartOfFrame {
+				if offset+9 > len(data) {
+					break
+				}
+				return int(binary.BigEndian.Uint16(data[offset+7 : offset+9])),
+					int(binary.BigEndian.Uint16(data[offset+5 : offset+7])), nil
+			}
+			offset += 2 + segmentLength
+		}
+		return 0, 0, fmt.Errorf("JPEG size not found")
+	case bytes.HasPrefix(data, ktx2Identifier):
+		if len(data) < 28 {
+			return 0, 0, fmt.Errorf("truncated KTX2 header")
+		}
+		return int(binary.LittleEndian.Uint32(data[20:24])), int(binary.LittleEndian.Uint32(data[24:28])), nil
+	}
+	return 0, 0, fmt.Errorf("unsupported image format, must be PNG, JPEG or KTX2")
+}
+
+// truncateString shortens a string for display in an error message.
+func truncateString(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+	return s[:maxLength] + "..."
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// testGltfBudgets are generous budgets that the valid test models are within.
+var testGltfBudgets = GltfBudgets{MaxTriangleCount: 1000, MaxTextureSize: 1024}
+
+// testGltfBin returns the binary buffer of the test model: one triangle of 3 float VEC3 positions, followed by the
+// header of an 8x8 PNG image.
+func testGltfBin() []byte {
+	bin := make([]byte, 36, 60)
+	for i, value := range []float32{0, 0, 0, 1, 0, 0, 0, 1, 0} {
+		binary.LittleEndian.PutUint32(bin[i*4:], math.Float32bits(value))
+	}
+	png := make([]byte, 24)
+	copy(png, "\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR")
+	binary.BigEndian.PutUint32(png[16:], 8)
+	binary.BigEndian.PutUint32(png[20:], 8)
+	return append(bin, png...)
+}
+
+// testGltfDoc returns the json document of the test model, with its buffer embedded as a data uri if dataUri is set,
+// or in the GLB binary chunk otherwise.
+func testGltfDoc(dataUri bool) map[string]interface{} {
+	buffer := map[string]interface{}{"byteLength": 60}
+	if dataUri {
+		buffer["uri"] = "data:application/octet-stream;base64," + base64.StdEncoding.EncodeToString(testGltfBin())
+	}
+	return map[string]interface{}{
+		"asset":  map[string]interface{}{"version": "2.0"},
+		"scene":  0,
+		"scenes": []interface{}{map[string]interface{}{"nodes": []int{0}}},
+		"nodes":  []interface{}{map[string]interface{}{"mesh": 0}},
+		"meshes": []interface{}{map[string]interface{}{
+			"primitives": []interface{}{map[string]interface{}{"attributes": map[string]int{"POSITION": 0}}},
+		}},
+		"accessors": []interface{}{map[string]interface{}{
+			"bufferView": 0, "componentType": 5126, "count": 3, "type": "VEC3",
+			"min": []float64{0, 0, 0}, "max": []float64{1, 1, 0},
+		}},
+		"buffers": []interface{}{buffer},
+		"bufferViews": []interface{}{
+			map[string]interface{}{"buffer": 0, "byteOffset": 0, "byteLength": 36},
+			map[string]interface{}{"buffer": 0, "byteOffset": 36, "byteLength": 24},
+		},
+		"images":   []interface{}{map[string]interface{}{"bufferView": 1, "mimeType": "image/png"}},
+		"textures": []interface{}{map[string]interface{}{"source": 0}},
+	}
+}
+
+// testGlb returns a GLB file with a json chunk and an optional binary chunk.
+func testGlb(jsonChunk []byte, binChunk []byte) []byte {
+	for len(jsonChunk)%4 != 0 {
+		jsonChunk = append(jsonChunk, ' ')
+	}
+	data := []byte("glTF\x02\x00\x00\x00\x00\x00\x00\x00")
+	appendChunk := func(chunkType uint32, chunk []byte) {
+		header := make([]byte, 8)
+		binary.LittleEndian.PutUint32(header[0:], uint32(len(chunk)))
+		binary.LittleEndian.PutUint32(header[4:], chunkType)
+		data = append(append(data, header...), chunk...)
+	}
+	appendChunk(glbChunkTypeJson, jsonChunk)
+	if binChunk != nil {
+		appendChunk(glbChunkTypeBin, binChunk)
+	}
+	binary.LittleEndian.PutUint32(data[8:], uint32(len(data)))
+	return data
+}
+
+// testGltfFile encodes a test document as a .glb file with the test binary chunk, or as a .gltf file.
+func testGltfFile(t *testing.T, doc map[string]interface{}, glb bool) []byte {
+	jsonData, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if glb {
+		return testGlb(jsonData, testGltfBin())
+	}
+	return jsonData
+}
+
+// setGltfField sets a field of the first element of a top level array in a test document.
+func setGltfField(doc map[string]interface{}, array string, index int, field string, value interface{}) {
+	doc[array].([]interface{})[index].(map[string]interface{})[field] = value
+}
+
+func TestLoadGltfValid(t *testing.T) {
+	for _, glb := range []bool{true, false} {
+		fileName := "model.gltf"
+		if glb {
+			fileName = "model.glb"
+		}
+		info, err := LoadGltf(testGltfFile(t, testGltfDoc(!glb), glb), fileName, testGltfBudgets)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", fileName, err)
+		}
+		if info.TriangleCount != 1 {
+			t.Errorf("%s: got %d triangles, want 1", fileName, info.TriangleCount)
+		}
+		if info.BoundsMax != (Vec3{X: 1, Y: 1, Z: 0}) {
+			t.Errorf("%s: got bounds max %v, want (1, 1, 0)", fileName, info.BoundsMax)
+		}
+	}
+}
+
+func TestLoadGltfInvalid(t *testing.T) {
+	const maxInt64 = math.MaxInt64
+	tests := []struct {
+		name string
+		// Modifies the valid test document.
+		modify func(doc map[string]interface{})
+		// Replaces the whole GLB file, if set.
+		data []byte
+		// A substring of the expected error.
+		wantError string
+	}{
+		{name: "not a GLB", data: []byte("not a model"), wantError: "missing GLB header"},
+		{name: "GLB version", data: []byte("glTF\x01\x00\x00\x00\x0c\x00\x00\x00"), wantError: "unsupported GLB version"},
+		{name: "GLB length mismatch", data: []byte("glTF\x02\x00\x00\x00\xff\x00\x00\x00"),
+			wantError: "does not match file size"},
+		{name: "truncated chunk header", data: []byte("glTF\x02\x00\x00\x00\x10\x00\x00\x00\x04\x00\x00\x00"),
+			wantError: "truncated GLB chunk header"},
+		{name: "chunk length exceeds file", data: []byte(
+			"glTF\x02\x00\x00\x00\x14\x00\x00\x00\xff\xff\xff\xffJSON"), wantError: "exceeds file size"},
+		{name: "missing json chunk", data: []byte("glTF\x02\x00\x00\x00\x0c\x00\x00\x00"), wantError: "missing GLB json chunk"},
+		{name: "invalid json", data: testGlb([]byte("{"), nil), wantError: "invalid glTF json"},
+		{name: "glTF version", modify: func(doc map[string]interface{}) {
+			doc["asset"] = map[string]interface{}{"version": "1.0"}
+		}, wantError: "unsupported glTF version"},
+		{name: "external buffer", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "buffers", 0, "uri", "model.bin")
+		}, wantError: "references external uri"},
+		{name: "buffer length exceeds data", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "buffers", 0, "byteLength", 61)
+		}, wantError: "exceeds its data length"},
+		{name: "bufferView exceeds buffer", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "bufferViews", 0, "byteLength", 61)
+		}, wantError: "bufferView 0 range exceeds buffer"},
+		{name: "bufferView range overflow", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "bufferViews", 0, "byteOffset", int64(maxInt64-800))
+			setGltfField(doc, "bufferViews", 0, "byteLength", 1000)
+		}, wantError: "bufferView 0 range exceeds buffer"},
+		{name: "image bufferView range overflow", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "bufferViews", 1, "byteOffset", int64(9223372036854775000))
+			setGltfField(doc, "bufferViews", 1, "byteLength", 1000)
+		}, wantError: "bufferView 1 range exceeds buffer"},
+		{name: "negative bufferView offset", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "bufferViews", 0, "byteOffset", -4)
+		}, wantError: "bufferView 0 range exceeds buffer"},
+		{name: "accessor exceeds bufferView", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "accessors", 0, "count", 4)
+		}, wantError: "accessor 0 range exceeds bufferView"},
+		{name: "accessor stride overflow", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "bufferViews", 0, "byteStride", int64(maxInt64/2))
+		}, wantError: "accessor 0 range exceeds bufferView"},
+		{name: "accessor offset overflow", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "accessors", 0, "byteOffset", int64(maxInt64-4))
+		}, wantError: "accessor 0 range exceeds bufferView"},
+		{name: "accessor count too large", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "accessors", 0, "count", int64(maxInt64))
+		}, wantError: "accessor 0 count"},
+		{name: "accessor count too large without bufferView", modify: func(doc map[string]interface{}) {
+			delete(doc["accessors"].([]interface{})[0].(map[string]interface{}), "bufferView")
+			setGltfField(doc, "accessors", 0, "count", int64(maxInt64))
+		}, wantError: "accessor 0 count"},
+		{name: "accessor type", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "accessors", 0, "type", "VEC5")
+		}, wantError: "unknown type"},
+		{name: "mesh accessor out of range", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "meshes", 0, "primitives", []interface{}{
+				map[string]interface{}{"attributes": map[string]int{"POSITION": 7}}})
+		}, wantError: "accessor 7 out of range"},
+		{name: "node with two parents", modify: func(doc map[string]interface{}) {
+			doc["nodes"] = []interface{}{
+				map[string]interface{}{"children": []int{2}},
+				map[string]interface{}{"children": []int{2}},
+				map[string]interface{}{"mesh": 0},
+			}
+		}, wantError: "more than one parent"},
+		{name: "image bufferView out of range", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "images", 0, "bufferView", 5)
+		}, wantError: "image 0 bufferView 5 out of range"},
+		{name: "truncated image", modify: func(doc map[string]interface{}) {
+			setGltfField(doc, "bufferViews", 1, "byteLength", 20)
+		}, wantError: "truncated PNG header"},
+		{name: "triangle budget", modify: func(doc map[string]interface{}) {
+			doc["scenes"] = []interface{}{map[string]interface{}{"nodes": []int{0, 1}}}
+			doc["nodes"] = []interface{}{map[string]interface{}{"mesh": 0}, map[string]interface{}{"mesh": 1}}
+			primitives := make([]interface{}, 1001)
+			for i := range primitives {
+				primitives[i] = map[string]interface{}{"attributes": map[string]int{"POSITION": 0}}
+			}
+			doc["meshes"] = append(doc["meshes"].([]interface{}), map[string]interface{}{"primitives": primitives})
+		}, wantError: "exceeds the triangle budget"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := test.data
+			if data == nil {
+				doc := testGltfDoc(false)
+				test.modify(doc)
+				data = testGltfFile(t, doc, true)
+			}
+			_, err := LoadGltf(data, "model.glb", testGltfBudgets)
+			if err == nil {
+				t.Fatalf("got no error, want %q", test.wantError)
+			}
+			if !strings.Contains(err.Error(), test.wantError) {
+				t.Errorf("got error %q, want %q", err, test.wantError)
+			}
+		})
+	}
+}
+
+// TestLoadGltfMutations checks that randomly corrupted models are rejected or loaded without panicking.
+func TestLoadGltfMutations(t *testing.T) {
+	valid := testGltfFile(t, testGltfDoc(false), true)
+	random := rand.New(rand.NewSource(1))
+	interesting := []string{"-1", "0", "4294967296", "9223372036854775000", "9223372036854775807", "1e300", "null"}
+
+	for i := 0; i < 5000; i++ {
+		data := append([]byte(nil), valid...)
+		if i%2 == 0 {
+			// Corrupt random bytes anywhere in the file.
+			for j := random.Intn(4) + 1; j > 0; j-- {
+				data[random.Intn(len(data))] = byte(random.Intn(256))
+			}
+		} else {
+			// Replace a random number in the json chunk with an extreme value, keeping the chunk lengths valid.
+			doc := testGltfDoc(false)
+			jsonData, _ := json.Marshal(doc)
+			text := string(jsonData)
+			digits := []int{}
+			for j := 0; j < len(text); j++ {
+				if text[j] >= '0' && text[j] <= '9' && (j == 0 || text[j-1] < '0' || text[j-1] > '9') {
+					digits = append(digits, j)
+				}
+			}
+			start := digits[random.Intn(len(digits))]
+			end := start
+			for end < len(text) && text[end] >= '0' && text[end] <= '9' {
+				end++
+			}
+			text = text[:start] + interesting[random.Intn(len(interesting))] + text[end:]
+			data = testGlb([]byte(text), testGltfBin())
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("panic loading mutation %d: %v\n%q", i, r, data)
+				}
+			}()
+			LoadGltf(data, "model.glb", testGltfBudgets)
+		}()
+	}
+}
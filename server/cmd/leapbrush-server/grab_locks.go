@@ -0,0 +1,164 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Default time after which a grab lock expires unless the holder acquires it again.
+	defaultGrabLockDuration = 5 * time.Second
+)
+
+// HandleGrabLockAcquireLocked handles a request from a user to acquire or renew a grab lock on a 3D model or brush
+// stroke. The request is denied if another user holds the lock or the content is in a locked layer. The requesting
+// user is always sent the resulting lock state. s.lock must be held while calling this function.
+func (s *Server) HandleGrabLockAcquireLocked(userName string, req *pb.GrabLockRequest) {
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok {
+		return
+	}
+
+	_, isBrushStroke := anchorState.brushStrokes[req.ContentId]
+	_, isExternalModel := anchorState.externalModels[req.ContentId]
+	if (!isBrushStroke && !isExternalModel) || s.IsBrushStrokeLockedLocked(anchorState, req.ContentId) ||
+		s.IsExternalModelLockedLocked(anchorState, req.ContentId) {
+		log.Printf("User %s: *** Denied grab lock on %s on anchor %s: content missing or in a locked layer",
+			userName, req.ContentId, anchorState.id)
+		s.DistributeGrabLockRemoveLocked(anchorState, req.ContentId, userName)
+		return
+	}
+
+	if existingLock, ok := anchorState.grabLocks[req.ContentId]; ok && existingLock.UserName != userName {
+		if s.verbose {
+			log.Printf("User %s: Denied grab lock on %s on anchor %s held by %s",
+				userName, req.ContentId, anchorState.id, existingLock.UserName)
+		}
+		s.DistributeGrabLockAddLocked(anchorState, req.ContentId, userName)
+		return
+	}
+
+	userDisplayName := ""
+	if userStateEntry, ok := s.userStateMap[userName]; ok && userStateEntry.stateProto != nil {
+		userDisplayName = userStateEntry.stateProto.UserDisplayName
+	}
+	_, renewed := anchorState.grabLocks[req.ContentId]
+	anchorState.grabLocks[req.ContentId] = &pb.GrabLockProto{
+		ContentId:        req.ContentId,
+		AnchorId:         anchorState.id,
+		UserName:         userName,
+		UserDisplayName:  userDisplayName,
+		ExpireTimeMillis: time.Now().Add(s.Config().GrabLockDuration).UnixMilli(),
+	}
+	s.DistributeGrabLockAddLocked(anchorState, req.ContentId, "")
+	if s.verbose || !renewed {
+		log.Printf("User %s: Acquired grab lock on %s on anchor %s", userName, req.ContentId, anchorState.id)
+	}
+}
+
+// IsGrabbedByOtherUser returns whether a brush stroke or 3D model is grabbed by a user other than userName.
+func (a *AnchorState) IsGrabbedByOtherUser(contentId string, userName string) bool {
+	grabLock, ok := a.grabLocks[contentId]
+	return ok && grabLock.UserName != userName
+}
+
+// HandleGrabLockReleaseLocked handles a request from a user to release a grab lock they hold. s.lock must be held while
+// calling this function.
+func (s *Server) HandleGrabLockReleaseLocked(userName string, req *pb.GrabLockRequest) {
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok {
+		return
+	}
+
+	if existingLock, ok := anchorState.grabLocks[req.ContentId]; ok && existingLock.UserName == userName {
+		s.ReleaseGrabLockLocked(anchorState, req.ContentId)
+		log.Printf("User %s: Released grab lock on %s on anchor %s", userName, req.ContentId, anchorState.id)
+	}
+}
+
+// ReleaseGrabLockLocked removes a grab lock if one is held on a piece of content and distributes the release to all
+// users. s.lock must be held while calling this function.
+func (s *Server) ReleaseGrabLockLocked(anchorState *AnchorState, contentId string) {
+	if _, ok := anchorState.grabLocks[contentId]; !ok {
+		return
+	}
+	delete(anchorState.grabLocks, contentId)
+	s.DistributeGrabLockRemoveLocked(anchorState, contentId, "")
+}
+
+// ExpireGrabLocksLocked releases grab locks that have not been renewed in time or whose holder is no longer connected.
+// s.lock must be held while calling this function.
+func (s *Server) ExpireGrabLocksLocked(now time.Time) {
+	for _, anchorState := range s.anchorStateMap {
+		for contentId, grabLock := range anchorState.grabLocks {
+			_, holderConnected := s.userStateMap[grabLock.UserName]
+			if holderConnected && now.UnixMilli() < grabLock.ExpireTimeMillis {
+				continue
+			}
+			log.Printf("User %s: Grab lock on %s on anchor %s expired", grabLock.UserName, contentId, anchorState.id)
+			s.ReleaseGrabLockLocked(anchorState, contentId)
+		}
+	}
+}
+
+// DistributeGrabLockAddLocked sets notification bits for user connections, for a grab lock that was acquired or
+// renewed. If onlyUserName is not empty, only that user is notified. s.lock must be held while calling this function.
+func (s *Server) DistributeGrabLockAddLocked(anchorState *AnchorState, contentId string, onlyUserName string) {
+	for userToNotify := range anchorState.userSet {
+		if onlyUserName != "" && userToNotify != onlyUserName {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutGrabLockRemovals, contentId)
+			userConnectionEntry.notifyAboutGrabLockAdds[contentId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeGrabLockRemoveLocked sets notification bits for user connections, for a grab lock that was released. If
+// onlyUserName is not empty, only that user is notified. s.lock must be held while calling this function.
+func (s *Server) DistributeGrabLockRemoveLocked(anchorState *AnchorState, contentId string, onlyUserName string) {
+	for userToNotify := range anchorState.userSet {
+		if onlyUserName != "" && userToNotify != onlyUserName {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutGrabLockAdds, contentId)
+			userConnectionEntry.notifyAboutGrabLockRemovals[contentId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeMissingGrabLocksToUserLocked sets notify bits for all grab locks that a user hasn't been notified about
+// yet. s.lock must be held while calling this function.
+func (s *Server) DistributeMissingGrabLocksToUserLocked(userStateEntry *UserState, userConnectionState *UserConnectionState) {
+	if userStateEntry == nil {
+		userStateEntry = s.userStateMap[userConnectionState.userName]
+	}
+	if userConnectionState == nil {
+		userConnectionState = s.userConnectionsMap[userStateEntry.userName]
+	}
+	if userStateEntry == nil || userConnectionState == nil || userStateEntry.spaceInfoProto == nil {
+		return
+	}
+
+	for _, anchor := range userStateEntry.spaceInfoProto.Anchor {
+		if anchorState, ok := s.anchorStateMap[anchor.Id]; ok {
+			for contentId := range anchorState.grabLocks {
+				userConnectionState.notifyAboutGrabLockAdds[contentId] = anchor.Id
+			}
+		}
+	}
+}
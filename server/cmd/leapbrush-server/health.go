@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+// SetServing updates whether the server is ready to serve clients, reporting the status through the standard grpc
+// health service both for the server overall and for the LeapBrushApi service. Calls to the LeapBrushApi service are
+// rejected while not serving.
+func (s *Server) SetServing(serving bool) {
+	servingStatus := healthpb.HealthCheckResponse_NOT_SERVING
+	var servingValue int32 = 0
+	if serving {
+		servingStatus = healthpb.HealthCheckResponse_SERVING
+		servingValue = 1
+	}
+	if atomic.SwapInt32(&s.serving, servingValue) != servingValue {
+		log.Printf("Health status: %v", servingStatus)
+	}
+	if s.healthServer != nil {
+		s.healthServer.SetServingStatus("", servingStatus)
+		s.healthServer.SetServingStatus(pb.LeapBrushApi_ServiceDesc.ServiceName, servingStatus)
+	}
+}
+
+// CheckServing returns an Unavailable error for calls to the LeapBrushApi service while the server is not serving.
+func (s *Server) CheckServing(fullMethod string) error {
+	if atomic.LoadInt32(&s.serving) == 0 &&
+		strings.HasPrefix(fullMethod, "/"+pb.LeapBrushApi_ServiceDesc.ServiceName+"/") {
+		return status.Errorf(codes.Unavailable, "server is not serving")
+	}
+	return nil
+}
+
+// UnaryServingInterceptor rejects unary calls to the LeapBrushApi service while the server is not serving.
+func (s *Server) UnaryServingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.CheckServing(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServingInterceptor rejects streaming calls to the LeapBrushApi service while the server is not serving.
+func (s *Server) StreamServingInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.CheckServing(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// NewHealthServer creates the standard grpc health service, initially reporting NOT_SERVING.
+func NewHealthServer() *health.Server {
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthServer.SetServingStatus(pb.LeapBrushApi_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	return healthServer
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Maximum number of ancestors of a brush stroke or 3D model.
+	maxContentHierarchyDepth = 32
+)
+
+// ContentParentId returns the parent id of a brush stroke or 3D model, or empty if it has no parent or doesn't exist.
+func (a *AnchorState) ContentParentId(contentId string) string {
+	if brushStroke, ok := a.brushStrokes[contentId]; ok {
+		return brushStroke.ParentId
+	}
+	if model, ok := a.externalModels[contentId]; ok {
+		return model.GetParentId()
+	}
+	return ""
+}
+
+// ContentTransform returns the transform of a brush stroke or 3D model relative to its parent.
+func (a *AnchorState) ContentTransform(contentId string) *pb.TransformProto {
+	if brushStroke, ok := a.brushStrokes[contentId]; ok {
+		return brushStroke.Transform
+	}
+	if model, ok := a.externalModels[contentId]; ok {
+		return model.Transform
+	}
+	return nil
+}
+
+// ContentAnchorTransform returns the transform of a brush stroke or 3D model relative to the anchor, as it is rendered:
+// its own transform composed with the transforms of all of its ancestors, then with the transform of the group that
+// the root of its hierarchy belongs to. Content with a parent follows its parent, so the group transform of content
+// with a parent is not applied. Returns nil if none of these transforms are set.
+func (a *AnchorState) ContentAnchorTransform(contentId string) *pb.TransformProto {
+	transform := a.ContentTransform(contentId)
+	rootId := contentId
+	for depth := 0; depth < maxContentHierarchyDepth; depth++ {
+		parentId := a.ContentParentId(rootId)
+		if parentId == "" {
+			break
+		}
+		transform = ComposeTransforms(a.ContentTransform(parentId), transform)
+		rootId = parentId
+	}
+	return ComposeTransforms(a.ContentGroupTransform(rootId), transform)
+}
+
+// ContentChildIds returns the ids of all brush strokes and 3D models whose parent is parentId.
+func (a *AnchorState) ContentChildIds(parentId string) []string {
+	var childIds []string
+	for brushStrokeId, brushStroke := range a.brushStrokes {
+		if brushStroke.ParentId == parentId {
+			childIds = append(childIds, brushStrokeId)
+		}
+	}
+	for modelId, model := range a.externalModels {
+		if model.GetParentId() == parentId {
+			childIds = append(childIds, modelId)
+		}
+	}
+	return childIds
+}
+
+// CheckContentParent checks whether a brush stroke or 3D model may be attached to parentId: the parent must be a brush
+// stroke or 3D model on the same anchor, and attaching must not create a cycle or too deep a hierarchy.
+func (a *AnchorState) CheckContentParent(contentId string, parentId string) error {
+	if parentId == "" {
+		return nil
+	}
+	if parentId == contentId {
+		return fmt.Errorf("content cannot be its own parent")
+	}
+	_, isBrushStroke := a.brushStrokes[parentId]
+	_, isExternalModel := a.externalModels[parentId]
+	if !isBrushStroke && !isExternalModel {
+		return fmt.Errorf("parent %s not found on anchor %s", parentId, a.id)
+	}
+
+	depth := 1
+	for ancestorId := a.ContentParentId(parentId); ancestorId != ""; ancestorId = a.ContentParentId(ancestorId) {
+		if ancestorId == contentId {
+			return fmt.Errorf("parent %s is attached to %s", parentId, contentId)
+		}
+		depth++
+		if depth >= maxContentHierarchyDepth {
+			return fmt.Errorf("hierarchy deeper than %d levels", maxContentHierarchyDepth)
+		}
+	}
+	return nil
+}
+
+// RejectInvalidContentParentLocked checks whether an edit attaching a brush stroke or 3D model to parentId should be
+// rejected. If rejected, the user is sent the server's version of the content, or a removal if the server doesn't have
+// it. Returns true if the edit was rejected. s.lock must be held while calling this function.
+func (s *Server) RejectInvalidContentParentLocked(anchorState *AnchorState, contentId string, parentId string, isBrushStroke bool, userName string) bool {
+	err := anchorState.CheckContentParent(contentId, parentId)
+	if err == nil {
+		return false
+	}
+
+	log.Printf("User %s: *** Rejected parent %s for %s on anchor %s: %v",
+		userName, parentId, contentId, anchorState.id, err)
+
+	userConnectionEntry, ok := s.userConnectionsMap[userName]
+	if !ok {
+		return true
+	}
+
+	if isBrushStroke {
+		if _, ok := anchorState.brushStrokes[contentId]; ok {
+			userConnectionEntry.brushStrokeState[contentId] = &UserBrushStrokeState{anchorId: anchorState.id}
+			userConnectionEntry.notifyAboutBrushStrokeAdds[contentId] = anchorState.id
+		} else {
+			userConnectionEntry.notifyAboutBrushStrokeRemovals[contentId] = anchorState.id
+		}
+	} else {
+		if _, ok := anchorState.externalModels[contentId]; ok {
+			userConnectionEntry.notifyAboutExternalModelAdds[contentId] = anchorState.id
+		} else {
+			userConnectionEntry.notifyAboutExternalModelRemovals[contentId] = anchorState.id
+		}
+	}
+	select {
+	case userConnectionEntry.wakeUp <- true:
+	default:
+	}
+	return true
+}
+
+// RejectInvalidBrushStrokeParentLocked checks whether a new brush stroke should be rejected because of its parent, as
+// in RejectInvalidContentParentLocked. The parent of an existing brush stroke is only changed by a
+// BrushStrokeTransformRequest, so incremental updates are never rejected. s.lock must be held while calling this
+// function.
+func (s *Server) RejectInvalidBrushStrokeParentLocked(anchorState *AnchorState, brushStroke *pb.BrushStrokeProto, userName string) bool {
+	if _, ok := anchorState.brushStrokes[brushStroke.Id]; ok {
+		return false
+	}
+	return s.RejectInvalidContentParentLocked(anchorState, brushStroke.Id, brushStroke.ParentId, true, userName)
+}
+
+// RemoveContentDescendantsLocked removes all brush strokes and 3D models attached directly or indirectly to parentId
+// and distributes the removals to other users. Descendants in a locked layer or grabbed by another user are kept.
+// Returns the number of descendants removed. s.lock must be held while calling this function.
+func (s *Server) RemoveContentDescendantsLocked(anchorState *AnchorState, parentId string, senderUserName string, echo bool) int {
+	numRemoved := 0
+	for _, childId := range anchorState.ContentChildIds(parentId) {
+		if s.IsBrushStrokeLockedLocked(anchorState, childId) || s.IsExternalModelLockedLocked(anchorState, childId) {
+			continue
+		}
+		if anchorState.IsGrabbedByOtherUser(childId, senderUserName) {
+			continue
+		}
+
+		numRemoved += s.RemoveContentDescendantsLocked(anchorState, childId, senderUserName, echo)
+		if _, ok := anchorState.brushStrokes[childId]; ok {
+			s.RemoveBrushStrokeLocked(anchorState, childId, senderUserName, echo)
+		} else {
+			s.RemoveExternalModelLocked(anchorState, childId, senderUserName, echo)
+		}
+		numRemoved++
+	}
+	return numRemoved
+}
+
+// ReparentContentChildrenLocked attaches all brush strokes and 3D models whose parent is parentId to parentId's own
+// parent, composing their transforms so that they stay in place, and distributes the changes to all users. Called
+// before parentId is removed. s.lock must be held while calling this function.
+func (s *Server) ReparentContentChildrenLocked(anchorState *AnchorState, parentId string, senderUserName string) {
+	parentTransform := anchorState.ContentTransform(parentId)
+	newParentId := anchorState.ContentParentId(parentId)
+	for _, childId := range anchorState.ContentChildIds(parentId) {
+		if brushStroke, ok := anchorState.brushStrokes[childId]; ok {
+			brushStroke.Transform = ComposeTransforms(parentTransform, brushStroke.Transform)
+			brushStroke.ParentId = newParentId
+			s.DistributeBrushStrokeTransformLocked(anchorState, childId, senderUserName, true)
+		} else if model, ok := anchorState.externalModels[childId]; ok {
+			model = proto.Clone(model).(*pb.ExternalModelProto)
+			model.Transform = ComposeTransforms(parentTransform, model.Transform)
+			model.ParentId = proto.String(newParentId)
+			anchorState.externalModels[childId] = model
+			s.DistributeExternalModelAddLocked(anchorState, childId, senderUserName, true)
+		}
+		if s.verbose {
+			log.Printf("User %s: Reparented %s on anchor %s from %s to %s",
+				senderUserName, childId, anchorState.id, parentId, newParentId)
+		}
+	}
+}
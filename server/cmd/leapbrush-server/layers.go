@@ -0,0 +1,347 @@
+package main
+
+import (
+	"log"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+// HandleSetLayerLocked handles an rpc from a user to add or modify a layer. Adding a locked layer, locking or unlocking
+// a layer, or modifying a locked layer requires the admin token. s.lock must be held while calling this function.
+func (s *Server) HandleSetLayerLocked(userName string, req *pb.SetLayerRequest) error {
+	if req.Layer == nil || req.Layer.Id == "" {
+		return status.Errorf(codes.InvalidArgument, "layer id must not be empty")
+	}
+	if existingLayer, ok := s.layers[req.Layer.Id]; (req.Layer.Locked || (ok && existingLayer.Locked)) &&
+		!s.IsAdminTokenValid(req.AdminToken) {
+		log.Printf("User %s: *** Rejected change to locked layer %s with invalid admin token", userName, req.Layer.Id)
+		return status.Errorf(codes.PermissionDenied, "locking, unlocking or modifying a locked layer requires the "+
+			"admin token")
+	}
+
+	layer := proto.Clone(req.Layer).(*pb.LayerProto)
+	layer.UserVisible = nil
+	layer.ModifiedByUserName = userName
+	s.layers[layer.Id] = layer
+
+	log.Printf("User %s: Set layer %s (%v): visible %v, locked %v",
+		userName, layer.Id, layer.Name, layer.Visible, layer.Locked)
+
+	s.DistributeLayersLocked()
+	return nil
+}
+
+// HandleRemoveLayerLocked handles an rpc from a user to remove a layer, moving all content in that layer to the
+// default layer. Removing a locked layer requires the admin token. s.lock must be held while calling this function.
+func (s *Server) HandleRemoveLayerLocked(userName string, req *pb.RemoveLayerRequest) error {
+	layer, ok := s.layers[req.Id]
+	if !ok {
+		return status.Errorf(codes.NotFound, "layer %s not found", req.Id)
+	}
+	if layer.Locked && !s.IsAdminTokenValid(req.AdminToken) {
+		log.Printf("User %s: *** Rejected removal of locked layer %s with invalid admin token", userName, req.Id)
+		return status.Errorf(codes.PermissionDenied, "removing a locked layer requires the admin token")
+	}
+
+	delete(s.layers, req.Id)
+	for _, overrides := range s.layerVisibilityOverrides {
+		delete(overrides, req.Id)
+	}
+
+	for _, anchorState := range s.anchorStateMap {
+		for _, primitive := range anchorState.primitives {
+			if primitive.LayerId == req.Id {
+				s.SetPrimitiveLayerLocked(anchorState, primitive, "", userName)
+			}
+		}
+		for _, measurement := range anchorState.measurements {
+			if measurement.LayerId == req.Id {
+				s.SetMeasurementLayerLocked(anchorState, measurement, "", userName)
+			}
+		}
+		for _, textNote := range anchorState.textNotes {
+			if textNote.LayerId == req.Id {
+				s.SetTextNoteLayerLocked(anchorState, textNote, "", userName)
+			}
+		}
+		for _, brushStroke := range anchorState.brushStrokes {
+			if brushStroke.LayerId == req.Id {
+				s.SetBrushStrokeLayerLocked(anchorState, brushStroke, "", userName)
+			}
+		}
+		for _, model := range anchorState.externalModels {
+			if model.LayerId == req.Id {
+				s.SetExternalModelLayerLocked(anchorState, model, "", userName)
+			}
+		}
+	}
+
+	log.Printf("User %s: Removed layer %s (%v)", userName, layer.Id, layer.Name)
+
+	s.DistributeLayersLocked()
+	return nil
+}
+
+// HandleSetLayerVisibilityLocked handles an rpc from a user to override the visibility of a layer for themselves
+// only. s.lock must be held while calling this function.
+func (s *Server) HandleSetLayerVisibilityLocked(userName string, req *pb.SetLayerVisibilityRequest) error {
+	if _, ok := s.layers[req.LayerId]; !ok {
+		return status.Errorf(codes.NotFound, "layer %s not found", req.LayerId)
+	}
+
+	overrides, ok := s.layerVisibilityOverrides[userName]
+	if !ok {
+		overrides = make(map[string]bool)
+		s.layerVisibilityOverrides[userName] = overrides
+	}
+	if req.Visible != nil {
+		overrides[req.LayerId] = *req.Visible
+	} else {
+		delete(overrides, req.LayerId)
+	}
+
+	if s.verbose {
+		log.Printf("User %s: Set layer %s visibility override to %v", userName, req.LayerId, req.Visible)
+	}
+
+	if userConnectionEntry, ok := s.userConnectionsMap[userName]; ok {
+		userConnectionEntry.notifyAboutLayers = true
+		select {
+		case userConnectionEntry.wakeUp <- true:
+		default:
+		}
+	}
+	return nil
+}
+
+// HandleSetContentLayerLocked handles an rpc from a user to move brush strokes, 3d models, text notes, primitives and
+// measurements to a layer. Content in a locked layer cannot be moved. s.lock must be held while calling this function.
+func (s *Server) HandleSetContentLayerLocked(userName string, req *pb.SetContentLayerRequest) error {
+	if _, ok := s.layers[req.LayerId]; !ok && req.LayerId != "" {
+		return status.Errorf(codes.NotFound, "layer %s not found", req.LayerId)
+	}
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok {
+		return status.Errorf(codes.NotFound, "anchor %s not found", req.AnchorId)
+	}
+
+	for _, brushStrokeId := range req.BrushStrokeIds {
+		if s.IsBrushStrokeLockedLocked(anchorState, brushStrokeId) {
+			return status.Errorf(codes.FailedPrecondition, "brush stroke %s is in a locked layer", brushStrokeId)
+		}
+	}
+	for _, modelId := range req.ExternalModelIds {
+		if s.IsExternalModelLockedLocked(anchorState, modelId) {
+			return status.Errorf(codes.FailedPrecondition, "model %s is in a locked layer", modelId)
+		}
+	}
+	for _, textNoteId := range req.TextNoteIds {
+		if textNote, ok := anchorState.textNotes[textNoteId]; ok && s.IsLayerLockedLocked(textNote.LayerId) {
+			return status.Errorf(codes.FailedPrecondition, "text note %s is in a locked layer", textNoteId)
+		}
+	}
+	for _, primitiveId := range req.PrimitiveIds {
+		if primitive, ok := anchorState.primitives[primitiveId]; ok && s.IsLayerLockedLocked(primitive.LayerId) {
+			return status.Errorf(codes.FailedPrecondition, "primitive %s is in a locked layer", primitiveId)
+		}
+	}
+	for _, measurementId := range req.MeasurementIds {
+		if measurement, ok := anchorState.measurements[measurementId]; ok && s.IsLayerLockedLocked(measurement.LayerId) {
+			return status.Errorf(codes.FailedPrecondition, "measurement %s is in a locked layer", measurementId)
+		}
+	}
+
+	for _, brushStrokeId := range req.BrushStrokeIds {
+		if brushStroke, ok := anchorState.brushStrokes[brushStrokeId]; ok {
+			s.SetBrushStrokeLayerLocked(anchorState, brushStroke, req.LayerId, userName)
+		}
+	}
+	for _, modelId := range req.ExternalModelIds {
+		if model, ok := anchorState.externalModels[modelId]; ok {
+			s.SetExternalModelLayerLocked(anchorState, model, req.LayerId, userName)
+		}
+	}
+	for _, textNoteId := range req.TextNoteIds {
+		if textNote, ok := anchorState.textNotes[textNoteId]; ok {
+			s.SetTextNoteLayerLocked(anchorState, textNote, req.LayerId, userName)
+		}
+	}
+	for _, primitiveId := range req.PrimitiveIds {
+		if primitive, ok := anchorState.primitives[primitiveId]; ok {
+			s.SetPrimitiveLayerLocked(anchorState, primitive, req.LayerId, userName)
+		}
+	}
+	for _, measurementId := range req.MeasurementIds {
+		if measurement, ok := anchorState.measurements[measurementId]; ok {
+			s.SetMeasurementLayerLocked(anchorState, measurement, req.LayerId, userName)
+		}
+	}
+
+	if s.verbose {
+		log.Printf("User %s: Moved %d brush strokes, %d models, %d text notes, %d primitives and %d measurements on "+
+			"anchor %s to layer %s", userName, len(req.BrushStrokeIds), len(req.ExternalModelIds),
+			len(req.TextNoteIds), len(req.PrimitiveIds), len(req.MeasurementIds), anchorState.id, req.LayerId)
+	}
+	return nil
+}
+
+// SetBrushStrokeLayerLocked moves a brush stroke to a layer and resends the brush stroke to all users.
+// s.lock must be held while calling this function.
+func (s *Server) SetBrushStrokeLayerLocked(anchorState *AnchorState, brushStroke *pb.BrushStrokeProto, layerId string, senderUserName string) {
+	brushStroke = proto.Clone(brushStroke).(*pb.BrushStrokeProto)
+	brushStroke.LayerId = layerId
+	anchorState.brushStrokes[brushStroke.Id] = brushStroke
+	s.DistributeBrushStrokeAddLocked(anchorState, brushStroke.Id, 0, senderUserName, true)
+}
+
+// SetExternalModelLayerLocked moves a 3d model to a layer and resends the 3d model to all users.
+// s.lock must be held while calling this function.
+func (s *Server) SetExternalModelLayerLocked(anchorState *AnchorState, model *pb.ExternalModelProto, layerId string, senderUserName string) {
+	model = proto.Clone(model).(*pb.ExternalModelProto)
+	model.LayerId = layerId
+	anchorState.externalModels[model.Id] = model
+	s.DistributeExternalModelAddLocked(anchorState, model.Id, senderUserName, true)
+}
+
+// IsLayerLockedLocked checks whether a layer is locked. s.lock must be held while calling this function.
+func (s *Server) IsLayerLockedLocked(layerId string) bool {
+	if layer, ok := s.layers[layerId]; ok {
+		return layer.Locked
+	}
+	return false
+}
+
+// IsBrushStrokeLockedLocked checks whether a brush stroke exists and is in a locked layer. s.lock must be held while
+// calling this function.
+func (s *Server) IsBrushStrokeLockedLocked(anchorState *AnchorState, brushStrokeId string) bool {
+	if brushStroke, ok := anchorState.brushStrokes[brushStrokeId]; ok {
+		return s.IsLayerLockedLocked(brushStroke.LayerId)
+	}
+	return false
+}
+
+// IsExternalModelLockedLocked checks whether a 3d model exists and is in a locked layer. s.lock must be held while
+// calling this function.
+func (s *Server) IsExternalModelLockedLocked(anchorState *AnchorState, modelId string) bool {
+	if model, ok := anchorState.externalModels[modelId]; ok {
+		return s.IsLayerLockedLocked(model.LayerId)
+	}
+	return false
+}
+
+// RejectLockedBrushStrokeEditLocked checks whether an edit to a brush stroke should be rejected because the brush
+// stroke is grabbed by another user or in a locked layer, or because a new brush stroke would be added to the locked
+// layer newLayerId. If rejected, the user is sent the server's version of the brush stroke, or a removal if the server
+// doesn't have it. Returns true if the edit was rejected. s.lock must be held while calling this function.
+func (s *Server) RejectLockedBrushStrokeEditLocked(anchorState *AnchorState, brushStrokeId string, newLayerId string, userName string) bool {
+	if grabLock, ok := anchorState.grabLocks[brushStrokeId]; ok && grabLock.UserName != userName {
+		log.Printf("User %s: *** Rejected edit to brush stroke %s on anchor %s grabbed by %s",
+			userName, brushStrokeId, anchorState.id, grabLock.UserName)
+	} else {
+		if existingBrushStroke, ok := anchorState.brushStrokes[brushStrokeId]; ok {
+			if !s.IsLayerLockedLocked(existingBrushStroke.LayerId) {
+				return false
+			}
+		} else if !s.IsLayerLockedLocked(newLayerId) {
+			return false
+		}
+
+		log.Printf("User %s: *** Rejected edit to brush stroke %s on anchor %s in a locked layer",
+			userName, brushStrokeId, anchorState.id)
+	}
+
+	userConnectionEntry, ok := s.userConnectionsMap[userName]
+	if !ok {
+		return true
+	}
+
+	if _, ok := anchorState.brushStrokes[brushStrokeId]; ok {
+		userConnectionEntry.brushStrokeState[brushStrokeId] = &UserBrushStrokeState{anchorId: anchorState.id}
+		userConnectionEntry.notifyAboutBrushStrokeAdds[brushStrokeId] = anchorState.id
+	} else {
+		userConnectionEntry.notifyAboutBrushStrokeRemovals[brushStrokeId] = anchorState.id
+	}
+	select {
+	case userConnectionEntry.wakeUp <- true:
+	default:
+	}
+	return true
+}
+
+// RejectLockedExternalModelEditLocked checks whether an edit to a 3d model should be rejected because the 3d model is
+// grabbed by another user or in a locked layer, or because a new 3d model would be added to the locked layer
+// newLayerId. If rejected, the user is sent the server's version of the 3d model, or a removal if the server doesn't
+// have it. Returns true if the edit was rejected. s.lock must be held while calling this function.
+func (s *Server) RejectLockedExternalModelEditLocked(anchorState *AnchorState, modelId string, newLayerId string, userName string) bool {
+	if grabLock, ok := anchorState.grabLocks[modelId]; ok && grabLock.UserName != userName {
+		log.Printf("User %s: *** Rejected edit to model %s on anchor %s grabbed by %s",
+			userName, modelId, anchorState.id, grabLock.UserName)
+	} else {
+		if existingModel, ok := anchorState.externalModels[modelId]; ok {
+			if !s.IsLayerLockedLocked(existingModel.LayerId) {
+				return false
+			}
+		} else if !s.IsLayerLockedLocked(newLayerId) {
+			return false
+		}
+
+		log.Printf("User %s: *** Rejected edit to model %s on anchor %s in a locked layer",
+			userName, modelId, anchorState.id)
+	}
+
+	userConnectionEntry, ok := s.userConnectionsMap[userName]
+	if !ok {
+		return true
+	}
+
+	if _, ok := anchorState.externalModels[modelId]; ok {
+		userConnectionEntry.notifyAboutExternalModelAdds[modelId] = anchorState.id
+	} else {
+		userConnectionEntry.notifyAboutExternalModelRemovals[modelId] = anchorState.id
+	}
+	select {
+	case userConnectionEntry.wakeUp <- true:
+	default:
+	}
+	return true
+}
+
+// DistributeLayersLocked sets notification bits for all user connections so that they are sent the updated layer
+// list. s.lock must be held while calling this function.
+func (s *Server) DistributeLayersLocked() {
+	for _, userConnectionEntry := range s.userConnectionsMap {
+		userConnectionEntry.notifyAboutLayers = true
+		select {
+		case userConnectionEntry.wakeUp <- true:
+		default:
+		}
+	}
+}
+
+// BuildLayerListLocked builds the full list of layers sorted by name, including the visibility overrides for a user.
+// s.lock must be held while calling this function.
+func (s *Server) BuildLayerListLocked(userName string) *pb.LayerListProto {
+	layerList := &pb.LayerListProto{}
+
+	overrides := s.layerVisibilityOverrides[userName]
+	for _, layer := range s.layers {
+		if visible, ok := overrides[layer.Id]; ok {
+			layer = proto.Clone(layer).(*pb.LayerProto)
+			layer.UserVisible = proto.Bool(visible)
+		}
+		layerList.Layers = append(layerList.Layers, layer)
+	}
+	sort.Slice(layerList.Layers, func(i, j int) bool {
+		if layerList.Layers[i].Name != layerList.Layers[j].Name {
+			return layerList.Layers[i].Name < layerList.Layers[j].Name
+		}
+		return layerList.Layers[i].Id < layerList.Layers[j].Id
+	})
+
+	return layerList
+}
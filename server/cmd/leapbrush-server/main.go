@@ -5,30 +5,94 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"syscall"
+	"time"
 
 	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	// Time to wait after notifying clients of a shutdown before closing their listening streams.
+	shutdownNoticeDelay = 2 * time.Second
 )
 
 var (
-	grpcPort = flag.Int("grpc-port", 8402, "The grpc server port")
-	verbose  = flag.Bool("verbose", false, "Whether to enable verbose logging")
+	configFile = flag.String("config", "",
+		"Optional YAML or JSON config file, reloaded on SIGHUP. Flags override settings in the file")
+
+	grpcPort    = flag.Int("grpc-port", 8402, "The grpc server port")
+	metricsPort = flag.Int("metrics-port", 0, "The http port for Prometheus metrics at /metrics, or 0 to disable")
+	verbose     = flag.Bool("verbose", false, "Whether to enable verbose logging")
+
+	dashboardPort = flag.Int("dashboard-port", 0,
+		"The http port for the web dashboard of connected users and anchors, or 0 to disable")
+	spectatorPort = flag.Int("spectator-port", 0,
+		"The http port for the browser-based 3D web spectator, or 0 to disable")
+	restPort = flag.Int("rest-port", 0, "The http port for the JSON/REST gateway at /v1/, or 0 to disable")
+
+	shutdownTimeout = flag.Duration("shutdown-timeout", 15*time.Second,
+		"The maximum time to wait for clients to disconnect when shutting down")
+	reconnectAddress = flag.String("reconnect-address", "",
+		"Optional address (host:port) sent to clients when shutting down for them to reconnect to")
+
+	adminToken = flag.String("admin-token", "",
+		"The token required for admin requests. Admin requests are disabled if empty")
+	measurementUnits = flag.String("measurement-units", "metric",
+		"The default unit system for measurements, metric or imperial")
+
+	assetDir        = flag.String("asset-dir", "assets", "The directory for storing uploaded 3D model files")
+	maxModelSizeMb  = flag.Int64("max-model-size-mb", 64, "The maximum size in megabytes of an uploaded 3D model file")
+	modelCatalogDir = flag.String("model-catalog-dir", "",
+		"Optional directory of .glb and .gltf 3D model files to offer in the model catalog")
+	maxModelTriangles = flag.Int64("max-model-triangles", 500000,
+		"The maximum number of triangles drawn by a 3D model")
+	maxModelTextureSize = flag.Int("max-model-texture-size", 4096,
+		"The maximum width or height in pixels of each 3D model texture")
+
+	webhookUrls = flag.String("webhook-url", "",
+		"Comma-separated urls to send batches of user and content events to as JSON POST requests")
+	webhookSecret = flag.String("webhook-secret", "",
+		"The secret for signing webhook requests with HMAC-SHA256 in the X-LeapBrush-Signature header")
 )
 
 func main() {
 	flag.Parse()
 
-	server := Server{verbose: *verbose}
+	config, err := LoadConfig(*configFile)
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	unitSystem, _ := config.UnitSystem()
+
+	assetStore := &AssetStore{dir: config.AssetDir}
+	healthServer := NewHealthServer()
+
+	server := Server{
+		defaultUnitSystem: unitSystem,
+		assetStore:        assetStore,
+		healthServer:      healthServer,
+	}
+	server.ApplyConfig(config)
 	server.InitAndStart()
+	webhooks := StartWebhooks(&server)
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(server.UnaryServingInterceptor),
+		grpc.StreamInterceptor(server.StreamServingInterceptor))
 	pb.RegisterLeapBrushApiServer(grpcServer, &server)
+	pb.RegisterLeapBrushAdminServer(grpcServer, &AdminServer{server: &server})
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
 
 	grpcServerDone := make(chan bool)
 	go func() {
-		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *grpcPort))
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.GrpcPort))
 		if err != nil {
 			log.Fatalf("Failed to listen: %v", err)
 		}
@@ -39,17 +103,132 @@ func main() {
 		grpcServerDone <- true
 	}()
 
+	// Load stored state while the health service reports NOT_SERVING, then start serving clients.
+	if err := assetStore.Init(); err != nil {
+		log.Fatalf("Failed to initialize asset store: %v", err)
+	}
+	server.SetServing(true)
+
+	var metricsServer *http.Server
+	if config.MetricsPort != 0 {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/metrics", server.ServeMetrics)
+		metricsServer = &http.Server{Addr: fmt.Sprintf(":%d", config.MetricsPort), Handler: mux}
+		go func() {
+			log.Printf("Metrics server listening at %v", metricsServer.Addr)
+			if err := metricsServer.ListenAndServe(); err != http.ErrServerClosed {
+				log.Fatalf("Metrics server failed: %v", err)
+			}
+		}()
+	}
+
+	var dashboardServer *http.Server
+	if config.DashboardPort != 0 {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/", ServeDashboard)
+		mux.HandleFunc("/status", server.ServeDashboardStatus)
+		dashboardServer = &http.Server{Addr: fmt.Sprintf(":%d", config.DashboardPort), Handler: mux}
+		go func() {
+			log.Printf("Dashboard server listening at %v", dashboardServer.Addr)
+			if err := dashboardServer.ListenAndServe(); err != http.ErrServerClosed {
+				log.Fatalf("Dashboard server failed: %v", err)
+			}
+		}()
+	}
+
+	var spectatorServer *http.Server
+	if config.SpectatorPort != 0 {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/", ServeSpectator)
+		mux.HandleFunc("/spectate", server.ServeSpectate)
+		mux.HandleFunc("/anchors", server.ServeAnchorList)
+		mux.HandleFunc("/model-bounds", server.ServeModelBounds)
+		spectatorServer = &http.Server{Addr: fmt.Sprintf(":%d", config.SpectatorPort), Handler: mux}
+		go func() {
+			log.Printf("Web spectator server listening at %v", spectatorServer.Addr)
+			if err := spectatorServer.ListenAndServe(); err != http.ErrServerClosed {
+				log.Fatalf("Web spectator server failed: %v", err)
+			}
+		}()
+	}
+
+	var restServer *http.Server
+	if config.RestPort != 0 {
+		mux := http.NewServeMux()
+		mux.HandleFunc(restPathPrefix, server.ServeRest)
+		restServer = &http.Server{Addr: fmt.Sprintf(":%d", config.RestPort), Handler: mux}
+		go func() {
+			log.Printf("REST gateway listening at %v", restServer.Addr)
+			if err := restServer.ListenAndServe(); err != http.ErrServerClosed {
+				log.Fatalf("REST gateway failed: %v", err)
+			}
+		}()
+	}
+
+	reloadSignal := make(chan os.Signal, 1)
+	signal.Notify(reloadSignal, syscall.SIGHUP)
+	go func() {
+		for range reloadSignal {
+			server.ReloadConfig(*configFile)
+		}
+	}()
+
 	stopSignal := make(chan os.Signal, 1)
-	signal.Notify(stopSignal, os.Interrupt)
+	signal.Notify(stopSignal, os.Interrupt, syscall.SIGTERM)
 	<-stopSignal
 
-	log.Printf("Received stop signal...")
+	config = server.Config()
+	log.Printf("Received stop signal, draining connections for up to %v...", config.ShutdownTimeout)
 
-	grpcServer.Stop()
+	// Tell clients to finish up and reconnect, and give them time to receive the notice and send their last updates
+	// before their listening streams are closed.
+	shutdownDeadline := time.Now().Add(config.ShutdownTimeout)
+	server.NotifyShutdown(shutdownDeadline, config.ReconnectAddress)
+	noticeDelay := shutdownNoticeDelay
+	if noticeDelay > config.ShutdownTimeout/2 {
+		noticeDelay = config.ShutdownTimeout / 2
+	}
+	select {
+	case <-time.After(noticeDelay):
+	case <-stopSignal:
+		log.Printf("Received second stop signal, stopping now...")
+		shutdownDeadline = time.Now()
+	}
+
+	server.ShutDown()
+
+	// Wait for clients to close their update streams so that in-flight updates are handled, then stop.
+	gracefulStopDone := make(chan bool)
+	go func() {
+		grpcServer.GracefulStop()
+		gracefulStopDone <- true
+	}()
+	select {
+	case <-gracefulStopDone:
+	case <-time.After(time.Until(shutdownDeadline)):
+		log.Printf("Timed out waiting for connections to close, stopping now...")
+		grpcServer.Stop()
+	case <-stopSignal:
+		log.Printf("Received second stop signal, stopping now...")
+		grpcServer.Stop()
+	}
+	if metricsServer != nil {
+		metricsServer.Close()
+	}
+	if dashboardServer != nil {
+		dashboardServer.Close()
+	}
+	if spectatorServer != nil {
+		spectatorServer.Close()
+	}
+	if restServer != nil {
+		restServer.Close()
+	}
 
 	<-grpcServerDone
 
-	server.ShutDown()
+	// Give webhooks the rest of the shutdown timeout to send the events queued before shutting down.
+	webhooks.Stop(shutdownDeadline)
 
 	log.Printf("Shut down complete")
 }
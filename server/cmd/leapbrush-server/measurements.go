@@ -0,0 +1,341 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Maximum number of points in a measurement.
+	maxMeasurementPoints = 256
+
+	// Maximum distance in meters of a measurement point from its anchor.
+	maxMeasurementExtent = 1000
+
+	metersPerInch            = 0.0254
+	squareFeetPerSquareMeter = 1 / (12 * metersPerInch * 12 * metersPerInch)
+)
+
+// HandleSetRoomSettingsLocked handles an rpc from a user to change the room settings. All measurements are relabeled
+// in the new unit system and resent to all users. s.lock must be held while calling this function.
+func (s *Server) HandleSetRoomSettingsLocked(userName string, req *pb.SetRoomSettingsRequest) error {
+	if req.Settings == nil {
+		return status.Errorf(codes.InvalidArgument, "settings must be set")
+	}
+	if _, ok := pb.RoomSettingsProto_UnitSystem_name[int32(req.Settings.UnitSystem)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown unit system %v", req.Settings.UnitSystem)
+	}
+
+	roomSettings := proto.Clone(req.Settings).(*pb.RoomSettingsProto)
+	roomSettings.ModifiedByUserName = userName
+	unitSystemChanged := roomSettings.UnitSystem != s.roomSettings.UnitSystem
+	s.roomSettings = roomSettings
+
+	log.Printf("User %s: Set room settings: unit system %v", userName, roomSettings.UnitSystem)
+
+	for _, userConnectionEntry := range s.userConnectionsMap {
+		userConnectionEntry.notifyAboutRoomSettings = true
+		select {
+		case userConnectionEntry.wakeUp <- true:
+		default:
+		}
+	}
+
+	if unitSystemChanged {
+		for _, anchorState := range s.anchorStateMap {
+			for _, existingMeasurement := range anchorState.measurements {
+				measurement := proto.Clone(existingMeasurement).(*pb.MeasurementProto)
+				ComputeMeasurement(measurement, roomSettings.UnitSystem)
+				anchorState.measurements[measurement.Id] = measurement
+				s.DistributeMeasurementAddLocked(anchorState, measurement.Id, userName, true)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateMeasurement checks that a measurement has a valid number of finite points within range.
+func ValidateMeasurement(measurement *pb.MeasurementProto) error {
+	minPoints := 2
+	if measurement.Closed {
+		minPoints = 3
+	}
+	if len(measurement.Point) < minPoints || len(measurement.Point) > maxMeasurementPoints {
+		return fmt.Errorf("requires between %d and %d points, got %d",
+			minPoints, maxMeasurementPoints, len(measurement.Point))
+	}
+	for i, point := range measurement.Point {
+		if point == nil {
+			return fmt.Errorf("point %d is missing", i)
+		}
+		if p := Vec3FromProto(point); !p.IsFinite() || p.Length() > maxMeasurementExtent {
+			return fmt.Errorf("point %d is out of range", i)
+		}
+	}
+	return nil
+}
+
+// ComputeMeasurement fills in the segment lengths, total length, area, angles and display labels of a measurement
+// from its points, using the provided unit system for labels.
+func ComputeMeasurement(measurement *pb.MeasurementProto, unitSystem pb.RoomSettingsProto_UnitSystem) {
+	points := make([]Vec3, len(measurement.Point))
+	for i, point := range measurement.Point {
+		points[i] = Vec3FromProto(point)
+	}
+	numSegments := len(points) - 1
+	if measurement.Closed {
+		numSegments = len(points)
+	}
+
+	measurement.SegmentLength = nil
+	measurement.SegmentLengthLabel = nil
+	var totalLength float64
+	for i := 0; i < numSegments; i++ {
+		length := points[(i+1)%len(points)].Sub(points[i]).Length()
+		totalLength += length
+		measurement.SegmentLength = append(measurement.SegmentLength, float32(length))
+		measurement.SegmentLengthLabel = append(measurement.SegmentLengthLabel, FormatLength(length, unitSystem))
+	}
+	measurement.TotalLength = float32(totalLength)
+	measurement.TotalLengthLabel = FormatLength(totalLength, unitSystem)
+
+	// Angles are measured at every point with a segment on both sides.
+	measurement.AngleDegrees = nil
+	for i := range points {
+		if !measurement.Closed && (i == 0 || i == len(points)-1) {
+			continue
+		}
+		prev := points[(i+len(points)-1)%len(points)].Sub(points[i])
+		next := points[(i+1)%len(points)].Sub(points[i])
+		var angle float64
+		if prevLength, nextLength := prev.Length(), next.Length(); prevLength > 0 && nextLength > 0 {
+			angle = math.Acos(math.Max(-1, math.Min(1, prev.Dot(next)/(prevLength*nextLength)))) * 180 / math.Pi
+		}
+		measurement.AngleDegrees = append(measurement.AngleDegrees, float32(angle))
+	}
+
+	// The area of a closed polygon is half the length of the sum of cross products of consecutive points, which is
+	// exact for planar polygons.
+	measurement.Area = 0
+	measurement.AreaLabel = ""
+	if measurement.Closed && len(points) >= 3 {
+		var sum Vec3
+		for i := range points {
+			sum = sum.Add(points[i].Cross(points[(i+1)%len(points)]))
+		}
+		area := sum.Length() / 2
+		measurement.Area = float32(area)
+		measurement.AreaLabel = FormatArea(area, unitSystem)
+	}
+
+	measurement.UnitSystem = unitSystem
+}
+
+// FormatLength formats a length in meters for display in the provided unit system.
+func FormatLength(meters float64, unitSystem pb.RoomSettingsProto_UnitSystem) string {
+	if unitSystem == pb.RoomSettingsProto_IMPERIAL {
+		tenthsOfInch := int64(math.Round(meters / metersPerInch * 10))
+		feet := tenthsOfInch / 120
+		inches := float64(tenthsOfInch%120) / 10
+		if feet == 0 {
+			return fmt.Sprintf("%.1f\"", inches)
+		}
+		return fmt.Sprintf("%d' %.1f\"", feet, inches)
+	}
+
+	if meters < 1 {
+		return fmt.Sprintf("%.1f cm", meters*100)
+	}
+	return fmt.Sprintf("%.2f m", meters)
+}
+
+// FormatArea formats an area in square meters for display in the provided unit system.
+func FormatArea(squareMeters float64, unitSystem pb.RoomSettingsProto_UnitSystem) string {
+	if unitSystem == pb.RoomSettingsProto_IMPERIAL {
+		return fmt.Sprintf("%.2f ft²", squareMeters*squareFeetPerSquareMeter)
+	}
+	return fmt.Sprintf("%.2f m²", squareMeters)
+}
+
+// HandleMeasurementAddLocked handles a measurement that was added or modified by a user. The server computes all
+// measured values from the points. s.lock must be held while calling this function.
+func (s *Server) HandleMeasurementAddLocked(userName string, req *pb.MeasurementAddRequest, echo bool) {
+	if req.Measurement == nil {
+		return
+	}
+	anchorState, ok := s.anchorStateMap[req.Measurement.AnchorId]
+	if !ok || s.RejectLockedMeasurementEditLocked(anchorState, req.Measurement.Id, req.Measurement.LayerId, userName) {
+		return
+	}
+	if err := ValidateMeasurement(req.Measurement); err != nil {
+		log.Printf("User %s: *** Warning: ignoring invalid measurement %s: %v", userName, req.Measurement.Id, err)
+		return
+	}
+
+	measurement := proto.Clone(req.Measurement).(*pb.MeasurementProto)
+	measurement.ModifiedByUserName = userName
+	ComputeMeasurement(measurement, s.roomSettings.UnitSystem)
+	if existingMeasurement, ok := anchorState.measurements[measurement.Id]; ok {
+		measurement.UserName = existingMeasurement.UserName
+		measurement.LayerId = existingMeasurement.LayerId
+	} else {
+		measurement.UserName = userName
+		anchorState.contentInfo[measurement.Id] = &ContentInfo{createdByUserName: userName, createTime: time.Now()}
+		s.PublishEventLocked(pb.ServerEventProto_MEASUREMENT_ADDED, userName, anchorState.id, measurement.Id, "")
+	}
+	anchorState.measurements[measurement.Id] = measurement
+
+	// The sender is always sent the measurement so it receives the computed values.
+	s.DistributeMeasurementAddLocked(anchorState, measurement.Id, userName, true)
+	if s.verbose {
+		log.Printf("User %s: Create or update measurement %s (%d points, %s) for anchor %s",
+			userName, measurement.Id, len(measurement.Point), measurement.TotalLengthLabel, anchorState.id)
+	}
+}
+
+// HandleMeasurementRemoveLocked handles a measurement that was removed by a user. s.lock must be held while calling
+// this function.
+func (s *Server) HandleMeasurementRemoveLocked(userName string, req *pb.MeasurementRemoveRequest, echo bool) {
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok || s.RejectLockedMeasurementEditLocked(anchorState, req.Id, "", userName) {
+		return
+	}
+
+	s.RemoveMeasurementLocked(anchorState, req.Id, userName, echo)
+	if s.verbose {
+		log.Printf("User %s: Removed measurement %s from anchor %s", userName, req.Id, anchorState.id)
+	}
+}
+
+// RemoveMeasurementLocked removes a measurement and its comments from an anchor and distributes the removal to other
+// users. s.lock must be held while calling this function.
+func (s *Server) RemoveMeasurementLocked(anchorState *AnchorState, measurementId string, senderUserName string, echo bool) {
+	delete(anchorState.measurements, measurementId)
+	delete(anchorState.contentInfo, measurementId)
+	s.RemoveContentFromGroupLocked(anchorState, measurementId, senderUserName, echo)
+	s.RemoveContentCommentsLocked(anchorState, measurementId, senderUserName, echo)
+	s.DistributeMeasurementRemoveLocked(anchorState, measurementId, senderUserName, echo)
+	s.PublishEventLocked(pb.ServerEventProto_MEASUREMENT_REMOVED, senderUserName, anchorState.id, measurementId, "")
+}
+
+// SetMeasurementLayerLocked moves a measurement to a layer and resends the measurement to all users.
+// s.lock must be held while calling this function.
+func (s *Server) SetMeasurementLayerLocked(anchorState *AnchorState, measurement *pb.MeasurementProto, layerId string, senderUserName string) {
+	measurement = proto.Clone(measurement).(*pb.MeasurementProto)
+	measurement.LayerId = layerId
+	anchorState.measurements[measurement.Id] = measurement
+	s.DistributeMeasurementAddLocked(anchorState, measurement.Id, senderUserName, true)
+}
+
+// MeasurementInRegion checks whether any point of a measurement is inside the region described by an
+// EraseRegionRequest. groupTransform is the transform of the measurement's group, or nil if it isn't in a group.
+func MeasurementInRegion(region *pb.EraseRegionRequest, measurement *pb.MeasurementProto, groupTransform *pb.TransformProto) bool {
+	for _, point := range measurement.Point {
+		if RegionContains(region, TransformPoint(groupTransform, Vec3FromProto(point))) {
+			return true
+		}
+	}
+	return false
+}
+
+// RejectLockedMeasurementEditLocked checks whether an edit to a measurement should be rejected because the
+// measurement is in a locked layer, or because a new measurement would be added to the locked layer newLayerId. If
+// rejected, the user is sent the server's version of the measurement, or a removal if the server doesn't have it.
+// Returns true if the edit was rejected. s.lock must be held while calling this function.
+func (s *Server) RejectLockedMeasurementEditLocked(anchorState *AnchorState, measurementId string, newLayerId string, userName string) bool {
+	if existingMeasurement, ok := anchorState.measurements[measurementId]; ok {
+		if !s.IsLayerLockedLocked(existingMeasurement.LayerId) {
+			return false
+		}
+	} else if !s.IsLayerLockedLocked(newLayerId) {
+		return false
+	}
+
+	log.Printf("User %s: *** Rejected edit to measurement %s on anchor %s in a locked layer",
+		userName, measurementId, anchorState.id)
+
+	userConnectionEntry, ok := s.userConnectionsMap[userName]
+	if !ok {
+		return true
+	}
+
+	if _, ok := anchorState.measurements[measurementId]; ok {
+		userConnectionEntry.notifyAboutMeasurementAdds[measurementId] = anchorState.id
+	} else {
+		userConnectionEntry.notifyAboutMeasurementRemovals[measurementId] = anchorState.id
+	}
+	select {
+	case userConnectionEntry.wakeUp <- true:
+	default:
+	}
+	return true
+}
+
+// DistributeMeasurementAddLocked sets notification bits for user connections, for a measurement that was added or
+// modified. s.lock must be held while calling this function.
+func (s *Server) DistributeMeasurementAddLocked(anchorState *AnchorState, measurementId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutMeasurementRemovals, measurementId)
+			userConnectionEntry.notifyAboutMeasurementAdds[measurementId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeMeasurementRemoveLocked sets notification bits for user connections, for a measurement that was removed.
+// s.lock must be held while calling this function.
+func (s *Server) DistributeMeasurementRemoveLocked(anchorState *AnchorState, measurementId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutMeasurementAdds, measurementId)
+			userConnectionEntry.notifyAboutMeasurementRemovals[measurementId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeMissingMeasurementsToUserLocked sets notify bits for all measurements that a user hasn't been notified
+// about yet. s.lock must be held while calling this function.
+func (s *Server) DistributeMissingMeasurementsToUserLocked(userStateEntry *UserState, userConnectionState *UserConnectionState) {
+	if userStateEntry == nil {
+		userStateEntry = s.userStateMap[userConnectionState.userName]
+	}
+	if userConnectionState == nil {
+		userConnectionState = s.userConnectionsMap[userStateEntry.userName]
+	}
+	if userStateEntry == nil || userConnectionState == nil || userStateEntry.spaceInfoProto == nil {
+		return
+	}
+
+	for _, anchor := range userStateEntry.spaceInfoProto.Anchor {
+		if anchorState, ok := s.anchorStateMap[anchor.Id]; ok {
+			for measurementId := range anchorState.measurements {
+				userConnectionState.notifyAboutMeasurementAdds[measurementId] = anchor.Id
+			}
+		}
+	}
+}
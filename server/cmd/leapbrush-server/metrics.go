@@ -0,0 +1,282 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// Histogram bucket upper bounds in seconds for sending a server state response to a client.
+	sendLatencyBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5}
+
+	// Histogram bucket upper bounds in seconds for waiting to acquire the server lock.
+	lockWaitBuckets = []float64{0.00001, 0.00005, 0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5}
+)
+
+// Histogram accumulates observations into cumulative buckets for the Prometheus text exposition format. Observations
+// made before Init is called are dropped.
+type Histogram struct {
+	lock   sync.Mutex
+	bounds []float64
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// Init sets the bucket upper bounds of the histogram, which must be sorted in increasing order.
+func (h *Histogram) Init(bounds []float64) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.bounds = bounds
+	h.counts = make([]uint64, len(bounds))
+}
+
+// Observe adds a value to the histogram.
+func (h *Histogram) Observe(value float64) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.counts == nil {
+		return
+	}
+	for i, bound := range h.bounds {
+		if value <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+// MeteredMutex is a mutex that records how long callers wait to acquire it.
+type MeteredMutex struct {
+	mutex sync.Mutex
+	// Seconds spent waiting in Lock.
+	waitSeconds Histogram
+}
+
+func (m *MeteredMutex) Lock() {
+	start := time.Now()
+	m.mutex.Lock()
+	m.waitSeconds.Observe(time.Since(start).Seconds())
+}
+
+func (m *MeteredMutex) Unlock() {
+	m.mutex.Unlock()
+}
+
+// ServerMetrics contains counters and histograms for server traffic. Counters are updated atomically and may be read
+// without holding s.lock.
+type ServerMetrics struct {
+	// Number of UpdateDeviceRequests received.
+	updatesReceived uint64
+	// Number of Rpc calls received.
+	rpcsReceived uint64
+	// Number of server state responses sent, and their total size in bytes.
+	responsesSent uint64
+	bytesSent     uint64
+	// Number of server state responses that failed to send.
+	sendFailures uint64
+	// Number of users expired for not sending updates.
+	userTimeouts uint64
+	// Number of events sent to webhooks, and dropped because a webhook queue was full or requests kept failing.
+	webhookEventsSent    uint64
+	webhookEventsDropped uint64
+	// Number of webhook requests that failed, including those that were retried.
+	webhookFailures uint64
+	// Seconds spent sending each server state response.
+	sendLatencySeconds Histogram
+}
+
+// metricsGauges contains the metrics copied from server state while holding s.lock.
+type metricsGauges struct {
+	Connections     []connectionGauges
+	NumTrackedUsers int
+	Anchors         []anchorGauges
+}
+
+// connectionGauges contains the metrics for a connected listening stream.
+type connectionGauges struct {
+	UserName  string
+	BytesSent uint64
+}
+
+// anchorGauges contains the metrics for a spatial anchor.
+type anchorGauges struct {
+	AnchorId          string
+	NumBrushStrokes   int
+	NumBrushPoses     int
+	NumExternalModels int
+	NumUsers          int
+}
+
+// metricsWriter writes metrics in the Prometheus text exposition format.
+type metricsWriter struct {
+	w *bufio.Writer
+}
+
+// Header writes the help and type lines for a metric.
+func (m metricsWriter) Header(name string, metricType string, help string) {
+	fmt.Fprintf(m.w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// Sample writes a single sample. labels alternates label names and values.
+func (m metricsWriter) Sample(name string, value float64, labels ...string) {
+	m.w.WriteString(name)
+	if len(labels) > 0 {
+		m.w.WriteString("{")
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				m.w.WriteString(",")
+			}
+			fmt.Fprintf(m.w, "%s=\"%s\"", labels[i], escapeMetricLabelValue(labels[i+1]))
+		}
+		m.w.WriteString("}")
+	}
+	fmt.Fprintf(m.w, " %s\n", formatMetricValue(value))
+}
+
+// Metric writes the header and a single unlabeled sample for a metric.
+func (m metricsWriter) Metric(name string, metricType string, help string, value float64) {
+	m.Header(name, metricType, help)
+	m.Sample(name, value)
+}
+
+// Histogram writes the header, buckets, sum and count of a histogram.
+func (m metricsWriter) Histogram(name string, help string, h *Histogram) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	m.Header(name, "histogram", help)
+	for i, bound := range h.bounds {
+		m.Sample(name+"_bucket", float64(h.counts[i]), "le", formatMetricValue(bound))
+	}
+	m.Sample(name+"_bucket", float64(h.count), "le", "+Inf")
+	m.Sample(name+"_sum", h.sum)
+	m.Sample(name+"_count", float64(h.count))
+}
+
+func formatMetricValue(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func escapeMetricLabelValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return strings.ReplaceAll(value, "\n", `\n`)
+}
+
+// ServeMetrics handles an HTTP request for the server's metrics in the Prometheus text exposition format.
+func (s *Server) ServeMetrics(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m := metricsWriter{w: bufio.NewWriter(w)}
+	defer m.w.Flush()
+
+	m.Metric("leapbrush_updates_received_total", "counter", "Number of device state updates received.",
+		float64(atomic.LoadUint64(&s.metrics.updatesReceived)))
+	m.Metric("leapbrush_rpcs_received_total", "counter", "Number of rpc calls received.",
+		float64(atomic.LoadUint64(&s.metrics.rpcsReceived)))
+	m.Metric("leapbrush_responses_sent_total", "counter", "Number of server state responses sent.",
+		float64(atomic.LoadUint64(&s.metrics.responsesSent)))
+	m.Metric("leapbrush_sent_bytes_total", "counter", "Total size of server state responses sent.",
+		float64(atomic.LoadUint64(&s.metrics.bytesSent)))
+	m.Metric("leapbrush_send_failures_total", "counter", "Number of server state responses that failed to send.",
+		float64(atomic.LoadUint64(&s.metrics.sendFailures)))
+	m.Metric("leapbrush_user_timeouts_total", "counter", "Number of users expired for not sending updates.",
+		float64(atomic.LoadUint64(&s.metrics.userTimeouts)))
+	m.Metric("leapbrush_webhook_events_sent_total", "counter", "Number of events sent to webhooks.",
+		float64(atomic.LoadUint64(&s.metrics.webhookEventsSent)))
+	m.Metric("leapbrush_webhook_events_dropped_total", "counter", "Number of events dropped without reaching a webhook.",
+		float64(atomic.LoadUint64(&s.metrics.webhookEventsDropped)))
+	m.Metric("leapbrush_webhook_failures_total", "counter", "Number of webhook requests that failed.",
+		float64(atomic.LoadUint64(&s.metrics.webhookFailures)))
+	m.Histogram("leapbrush_send_latency_seconds", "Time spent sending each server state response.",
+		&s.metrics.sendLatencySeconds)
+	m.Histogram("leapbrush_lock_wait_seconds", "Time spent waiting to acquire the server lock.",
+		&s.lock.waitSeconds)
+
+	gauges := s.SnapshotMetricsGauges()
+
+	m.Metric("leapbrush_user_connections", "gauge", "Number of users with a connected listening stream.",
+		float64(len(gauges.Connections)))
+	m.Metric("leapbrush_tracked_users", "gauge", "Number of users that have sent updates and not timed out.",
+		float64(gauges.NumTrackedUsers))
+	m.Metric("leapbrush_anchors", "gauge", "Number of spatial anchors known to the server.",
+		float64(len(gauges.Anchors)))
+
+	m.Header("leapbrush_connection_sent_bytes_total", "counter",
+		"Total size of server state responses sent on each connected listening stream.")
+	for _, connection := range gauges.Connections {
+		m.Sample("leapbrush_connection_sent_bytes_total", float64(connection.BytesSent),
+			"user_name", connection.UserName)
+	}
+
+	m.Header("leapbrush_anchor_brush_strokes", "gauge", "Number of brush strokes attached to each anchor.")
+	for _, anchor := range gauges.Anchors {
+		m.Sample("leapbrush_anchor_brush_strokes", float64(anchor.NumBrushStrokes), "anchor_id", anchor.AnchorId)
+	}
+	m.Header("leapbrush_anchor_brush_poses", "gauge", "Number of brush stroke poses attached to each anchor.")
+	for _, anchor := range gauges.Anchors {
+		m.Sample("leapbrush_anchor_brush_poses", float64(anchor.NumBrushPoses), "anchor_id", anchor.AnchorId)
+	}
+	m.Header("leapbrush_anchor_external_models", "gauge", "Number of 3D models attached to each anchor.")
+	for _, anchor := range gauges.Anchors {
+		m.Sample("leapbrush_anchor_external_models", float64(anchor.NumExternalModels), "anchor_id", anchor.AnchorId)
+	}
+	m.Header("leapbrush_anchor_users", "gauge", "Number of users that have found each anchor.")
+	for _, anchor := range gauges.Anchors {
+		m.Sample("leapbrush_anchor_users", float64(anchor.NumUsers), "anchor_id", anchor.AnchorId)
+	}
+}
+
+// SnapshotMetricsGauges copies the metrics that require s.lock, so that they can be written to a slow HTTP connection
+// after the lock is released.
+func (s *Server) SnapshotMetricsGauges() *metricsGauges {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	gauges := &metricsGauges{
+		Connections:     make([]connectionGauges, 0, len(s.userConnectionsMap)),
+		NumTrackedUsers: len(s.userStateMap),
+		Anchors:         make([]anchorGauges, 0, len(s.anchorStateMap)),
+	}
+	for userName, userConnectionEntry := range s.userConnectionsMap {
+		gauges.Connections = append(gauges.Connections, connectionGauges{
+			UserName:  userName,
+			BytesSent: atomic.LoadUint64(&userConnectionEntry.bytesSent),
+		})
+	}
+	sort.Slice(gauges.Connections, func(i, j int) bool {
+		return gauges.Connections[i].UserName < gauges.Connections[j].UserName
+	})
+
+	for anchorId, anchorState := range s.anchorStateMap {
+		anchor := anchorGauges{
+			AnchorId:          anchorId,
+			NumBrushStrokes:   len(anchorState.brushStrokes),
+			NumExternalModels: len(anchorState.externalModels),
+			NumUsers:          len(anchorState.userSet),
+		}
+		for _, brushStroke := range anchorState.brushStrokes {
+			anchor.NumBrushPoses += len(brushStroke.BrushPose)
+		}
+		gauges.Anchors = append(gauges.Anchors, anchor)
+	}
+	sort.Slice(gauges.Anchors, func(i, j int) bool {
+		return gauges.Anchors[i].AnchorId < gauges.Anchors[j].AnchorId
+	})
+
+	return gauges
+}
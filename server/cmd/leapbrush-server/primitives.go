@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Maximum distance in meters of a primitive control point from its transform origin.
+	maxPrimitiveExtent = 1000
+
+	// Maximum line width in meters for a primitive.
+	maxPrimitiveLineWidth = 1
+)
+
+// primitiveControlPointCounts contains the required number of control points for each primitive shape.
+var primitiveControlPointCounts = map[pb.PrimitiveProto_Shape]int{
+	pb.PrimitiveProto_LINE:          2,
+	pb.PrimitiveProto_ARROW:         2,
+	pb.PrimitiveProto_MEASURED_LINE: 2,
+	pb.PrimitiveProto_BOX:           2,
+	pb.PrimitiveProto_SPHERE:        2,
+	pb.PrimitiveProto_CYLINDER:      3,
+}
+
+// ValidatePrimitive checks that a primitive has a known shape with the expected number of finite, non-degenerate
+// control points and a valid style.
+func ValidatePrimitive(primitive *pb.PrimitiveProto) error {
+	expectedControlPoints, ok := primitiveControlPointCounts[primitive.Shape]
+	if !ok {
+		return fmt.Errorf("unknown shape %v", primitive.Shape)
+	}
+	if len(primitive.ControlPoint) != expectedControlPoints {
+		return fmt.Errorf("%v requires %d control points, got %d",
+			primitive.Shape, expectedControlPoints, len(primitive.ControlPoint))
+	}
+
+	controlPoints := make([]Vec3, len(primitive.ControlPoint))
+	for i, controlPoint := range primitive.ControlPoint {
+		if controlPoint == nil {
+			return fmt.Errorf("control point %d is missing", i)
+		}
+		controlPoints[i] = Vec3FromProto(controlPoint)
+		if !controlPoints[i].IsFinite() || controlPoints[i].Length() > maxPrimitiveExtent {
+			return fmt.Errorf("control point %d is out of range", i)
+		}
+	}
+
+	const minSize = 1e-6
+	switch primitive.Shape {
+	case pb.PrimitiveProto_BOX:
+		size := controlPoints[1].Sub(controlPoints[0])
+		if math.Abs(size.X) < minSize || math.Abs(size.Y) < minSize || math.Abs(size.Z) < minSize {
+			return fmt.Errorf("box has zero volume")
+		}
+	case pb.PrimitiveProto_CYLINDER:
+		if controlPoints[1].Sub(controlPoints[0]).Length() < minSize {
+			return fmt.Errorf("cylinder has zero height")
+		}
+		if controlPoints[2].Sub(controlPoints[0]).Length() < minSize {
+			return fmt.Errorf("cylinder has zero radius")
+		}
+	default:
+		if controlPoints[1].Sub(controlPoints[0]).Length() < minSize {
+			return fmt.Errorf("%v has zero size", primitive.Shape)
+		}
+	}
+
+	if primitive.Transform != nil {
+		if !Vec3FromProto(primitive.Transform.Position).IsFinite() ||
+			!Vec3FromProto(primitive.Transform.Scale).IsFinite() {
+			return fmt.Errorf("transform is not finite")
+		}
+	}
+
+	if primitive.Style != nil {
+		lineWidth := float64(primitive.Style.LineWidth)
+		if math.IsNaN(lineWidth) || lineWidth < 0 || lineWidth > maxPrimitiveLineWidth {
+			return fmt.Errorf("line width %v is out of range", primitive.Style.LineWidth)
+		}
+	}
+
+	return nil
+}
+
+// HandlePrimitiveAddLocked handles a primitive that was added or modified by a user. s.lock must be held while
+// calling this function.
+func (s *Server) HandlePrimitiveAddLocked(userName string, req *pb.PrimitiveAddRequest, echo bool) {
+	if req.Primitive == nil {
+		return
+	}
+	anchorState, ok := s.anchorStateMap[req.Primitive.AnchorId]
+	if !ok || s.RejectLockedPrimitiveEditLocked(anchorState, req.Primitive.Id, req.Primitive.LayerId, userName) {
+		return
+	}
+	if err := ValidatePrimitive(req.Primitive); err != nil {
+		log.Printf("User %s: *** Warning: ignoring invalid primitive %s: %v", userName, req.Primitive.Id, err)
+		return
+	}
+
+	primitive := proto.Clone(req.Primitive).(*pb.PrimitiveProto)
+	primitive.ModifiedByUserName = userName
+	primitive.MeasuredLength = 0
+	if primitive.Shape == pb.PrimitiveProto_MEASURED_LINE {
+		primitive.MeasuredLength = float32(TransformPoint(primitive.Transform, Vec3FromProto(primitive.ControlPoint[1])).Sub(
+			TransformPoint(primitive.Transform, Vec3FromProto(primitive.ControlPoint[0]))).Length())
+	}
+	if existingPrimitive, ok := anchorState.primitives[primitive.Id]; ok {
+		primitive.UserName = existingPrimitive.UserName
+		primitive.LayerId = existingPrimitive.LayerId
+	} else {
+		primitive.UserName = userName
+		anchorState.contentInfo[primitive.Id] = &ContentInfo{createdByUserName: userName, createTime: time.Now()}
+		s.PublishEventLocked(pb.ServerEventProto_PRIMITIVE_ADDED, userName, anchorState.id, primitive.Id,
+			primitive.Shape.String())
+	}
+	anchorState.primitives[primitive.Id] = primitive
+
+	s.DistributePrimitiveAddLocked(anchorState, primitive.Id, userName, echo)
+	if s.verbose {
+		log.Printf("User %s: Create or update primitive %s (%v) for anchor %s",
+			userName, primitive.Id, primitive.Shape, anchorState.id)
+	}
+}
+
+// HandlePrimitiveRemoveLocked handles a primitive that was removed by a user. s.lock must be held while calling this
+// function.
+func (s *Server) HandlePrimitiveRemoveLocked(userName string, req *pb.PrimitiveRemoveRequest, echo bool) {
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok || s.RejectLockedPrimitiveEditLocked(anchorState, req.Id, "", userName) {
+		return
+	}
+
+	s.RemovePrimitiveLocked(anchorState, req.Id, userName, echo)
+	if s.verbose {
+		log.Printf("User %s: Removed primitive %s from anchor %s", userName, req.Id, anchorState.id)
+	}
+}
+
+// RemovePrimitiveLocked removes a primitive and its comments from an anchor and distributes the removal to other
+// users. s.lock must be held while calling this function.
+func (s *Server) RemovePrimitiveLocked(anchorState *AnchorState, primitiveId string, senderUserName string, echo bool) {
+	delete(anchorState.primitives, primitiveId)
+	delete(anchorState.contentInfo, primitiveId)
+	s.RemoveContentFromGroupLocked(anchorState, primitiveId, senderUserName, echo)
+	s.RemoveContentCommentsLocked(anchorState, primitiveId, senderUserName, echo)
+	s.DistributePrimitiveRemoveLocked(anchorState, primitiveId, senderUserName, echo)
+	s.PublishEventLocked(pb.ServerEventProto_PRIMITIVE_REMOVED, senderUserName, anchorState.id, primitiveId, "")
+}
+
+// SetPrimitiveLayerLocked moves a primitive to a layer and resends the primitive to all users.
+// s.lock must be held while calling this function.
+func (s *Server) SetPrimitiveLayerLocked(anchorState *AnchorState, primitive *pb.PrimitiveProto, layerId string, senderUserName string) {
+	primitive = proto.Clone(primitive).(*pb.PrimitiveProto)
+	primitive.LayerId = layerId
+	anchorState.primitives[primitive.Id] = primitive
+	s.DistributePrimitiveAddLocked(anchorState, primitive.Id, senderUserName, true)
+}
+
+// PrimitiveInRegion checks whether any control point of a primitive is inside the region described by an
+// EraseRegionRequest. groupTransform is the transform of the primitive's group, or nil if it isn't in a group.
+func PrimitiveInRegion(region *pb.EraseRegionRequest, primitive *pb.PrimitiveProto, groupTransform *pb.TransformProto) bool {
+	transform := ComposeTransforms(groupTransform, primitive.Transform)
+	for _, controlPoint := range primitive.ControlPoint {
+		if RegionContains(region, TransformPoint(transform, Vec3FromProto(controlPoint))) {
+			return true
+		}
+	}
+	return false
+}
+
+// RejectLockedPrimitiveEditLocked checks whether an edit to a primitive should be rejected because the primitive is
+// in a locked layer, or because a new primitive would be added to the locked layer newLayerId. If rejected, the user
+// is sent the server's version of the primitive, or a removal if the server doesn't have it. Returns true if the edit
+// was rejected. s.lock must be held while calling this function.
+func (s *Server) RejectLockedPrimitiveEditLocked(anchorState *AnchorState, primitiveId string, newLayerId string, userName string) bool {
+	if existingPrimitive, ok := anchorState.primitives[primitiveId]; ok {
+		if !s.IsLayerLockedLocked(existingPrimitive.LayerId) {
+			return false
+		}
+	} else if !s.IsLayerLockedLocked(newLayerId) {
+		return false
+	}
+
+	log.Printf("User %s: *** Rejected edit to primitive %s on anchor %s in a locked layer",
+		userName, primitiveId, anchorState.id)
+
+	userConnectionEntry, ok := s.userConnectionsMap[userName]
+	if !ok {
+		return true
+	}
+
+	if _, ok := anchorState.primitives[primitiveId]; ok {
+		userConnectionEntry.notifyAboutPrimitiveAdds[primitiveId] = anchorState.id
+	} else {
+		userConnectionEntry.notifyAboutPrimitiveRemovals[primitiveId] = anchorState.id
+	}
+	select {
+	case userConnectionEntry.wakeUp <- true:
+	default:
+	}
+	return true
+}
+
+// DistributePrimitiveAddLocked sets notification bits for user connections, for a primitive that was added or
+// modified. s.lock must be held while calling this function.
+func (s *Server) DistributePrimitiveAddLocked(anchorState *AnchorState, primitiveId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutPrimitiveRemovals, primitiveId)
+			userConnectionEntry.notifyAboutPrimitiveAdds[primitiveId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributePrimitiveRemoveLocked sets notification bits for user connections, for a primitive that was removed.
+// s.lock must be held while calling this function.
+func (s *Server) DistributePrimitiveRemoveLocked(anchorState *AnchorState, primitiveId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutPrimitiveAdds, primitiveId)
+			userConnectionEntry.notifyAboutPrimitiveRemovals[primitiveId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeMissingPrimitivesToUserLocked sets notify bits for all primitives that a user hasn't been notified about
+// yet. s.lock must be held while calling this function.
+func (s *Server) DistributeMissingPrimitivesToUserLocked(userStateEntry *UserState, userConnectionState *UserConnectionState) {
+	if userStateEntry == nil {
+		userStateEntry = s.userStateMap[userConnectionState.userName]
+	}
+	if userConnectionState == nil {
+		userConnectionState = s.userConnectionsMap[userStateEntry.userName]
+	}
+	if userStateEntry == nil || userConnectionState == nil || userStateEntry.spaceInfoProto == nil {
+		return
+	}
+
+	for _, anchor := range userStateEntry.spaceInfoProto.Anchor {
+		if anchorState, ok := s.anchorStateMap[anchor.Id]; ok {
+			for primitiveId := range anchorState.primitives {
+				userConnectionState.notifyAboutPrimitiveAdds[primitiveId] = anchor.Id
+			}
+		}
+	}
+}
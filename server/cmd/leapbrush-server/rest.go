@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Prefix of the paths served by the REST gateway.
+	restPathPrefix = "/v1/"
+	// Maximum size in bytes of a REST request body.
+	maxRestRequestSize = 1 << 20
+)
+
+var (
+	// Options for encoding REST responses. Field names match the .proto file so that scripts can use the same names
+	// as the api documentation.
+	restMarshalOptions = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: false}
+)
+
+// ServeRest handles a request to the REST gateway, which exposes LeapBrushApi queries and simple edits as JSON over
+// HTTP:
+//
+//	GET    /v1/users                                   QueryUsersResponse
+//	GET    /v1/anchors                                 ListAnchorsResponse, requires the admin token
+//	GET    /v1/anchors/<anchor id>                     AnchorContentProto, requires the admin token
+//	POST   /v1/anchors/<anchor id>/text-notes          TextNoteProto body, returns the stored TextNoteProto
+//	DELETE /v1/anchors/<anchor id>/text-notes/<id>
+//	POST   /v1/anchors/<anchor id>/clear               Optional ClearContentRequest body, returns ClearContentResponse
+//	GET    /v1/layers                                  LayerListProto
+//	GET    /v1/room-settings                           RoomSettingsProto
+//	GET    /v1/models?tag=<tag>                        QueryModelCatalogResponse
+//	POST   /v1/rpc                                     RpcRequest body, returns RpcResponse
+//
+// Edits are made as the user in the user_name query parameter. The admin token is sent as bearer authorization.
+// Listing and dumping anchors require it, as they do in the LeapBrushAdmin service, since users otherwise only receive
+// content for anchors they have found. Clearing an anchor with the admin token removes content created by any user.
+// Errors are returned as a google.rpc.Status.
+func (s *Server) ServeRest(w http.ResponseWriter, r *http.Request) {
+	if atomic.LoadInt32(&s.serving) == 0 {
+		WriteRestError(w, status.Errorf(codes.Unavailable, "server is not serving"))
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, restPathPrefix) {
+		WriteRestError(w, status.Errorf(codes.NotFound, "unknown path %s", r.URL.Path))
+		return
+	}
+	path := strings.Split(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, restPathPrefix), "/"), "/")
+
+	var resp proto.Message
+	var err error
+	switch {
+	case len(path) == 1 && path[0] == "users" && r.Method == http.MethodGet:
+		resp = s.HandleRestQueryUsers()
+	case len(path) == 1 && path[0] == "anchors" && r.Method == http.MethodGet:
+		s.lock.Lock()
+		if err = s.CheckRestAdminLocked(r, "list anchors"); err == nil {
+			resp = s.BuildAnchorListLocked()
+		}
+		s.lock.Unlock()
+	case len(path) == 2 && path[0] == "anchors" && r.Method == http.MethodGet:
+		s.lock.Lock()
+		if err = s.CheckRestAdminLocked(r, "dump anchor"); err == nil {
+			resp, err = s.DumpAnchorLocked(path[1])
+		}
+		s.lock.Unlock()
+	case len(path) == 3 && path[0] == "anchors" && path[2] == "text-notes" && r.Method == http.MethodPost:
+		textNote := &pb.TextNoteProto{}
+		if err = ReadRestRequest(r, textNote); err == nil {
+			resp, err = s.HandleRestTextNoteAdd(r.URL.Query().Get("user_name"), path[1], textNote)
+		}
+	case len(path) == 4 && path[0] == "anchors" && path[2] == "text-notes" && r.Method == http.MethodDelete:
+		if err = s.HandleRestTextNoteRemove(r.URL.Query().Get("user_name"), path[1], path[3]); err == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	case len(path) == 3 && path[0] == "anchors" && path[2] == "clear" && r.Method == http.MethodPost:
+		filter := &pb.ClearContentRequest{}
+		if err = ReadRestRequest(r, filter); err == nil {
+			filter.AnchorId = path[1]
+			resp, err = s.HandleRestClearContent(r.URL.Query().Get("user_name"), RestAdminToken(r), filter)
+		}
+	case len(path) == 1 && path[0] == "layers" && r.Method == http.MethodGet:
+		s.lock.Lock()
+		resp = s.BuildLayerListLocked("")
+		s.lock.Unlock()
+	case len(path) == 1 && path[0] == "room-settings" && r.Method == http.MethodGet:
+		s.lock.Lock()
+		resp = s.roomSettings
+		s.lock.Unlock()
+	case len(path) == 1 && path[0] == "models" && r.Method == http.MethodGet:
+		resp, err = s.HandleQueryModelCatalog("", &pb.QueryModelCatalogRequest{Tag: r.URL.Query().Get("tag")})
+	case len(path) == 1 && path[0] == "rpc" && r.Method == http.MethodPost:
+		req := &pb.RpcRequest{}
+		if err = ReadRestRequest(r, req); err == nil {
+			resp, err = s.Rpc(r.Context(), req)
+		}
+	default:
+		err = status.Errorf(codes.NotFound, "unknown request %s %s", r.Method, r.URL.Path)
+	}
+
+	if err != nil {
+		WriteRestError(w, err)
+		return
+	}
+	WriteRestResponse(w, http.StatusOK, resp)
+}
+
+// ReadRestRequest parses a JSON request body into a proto message. An empty body leaves the message unset.
+func ReadRestRequest(r *http.Request, message proto.Message) error {
+	data, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxRestRequestSize))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to read request body: %v", err)
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil
+	}
+	if err := protojson.Unmarshal(data, message); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid %s: %v", message.ProtoReflect().Descriptor().Name(), err)
+	}
+	return nil
+}
+
+// WriteRestResponse writes a proto message as a JSON response.
+func WriteRestResponse(w http.ResponseWriter, httpStatus int, message proto.Message) {
+	data, err := restMarshalOptions.Marshal(message)
+	if err != nil {
+		log.Printf("*** Warning: failed to encode REST response: %v", err)
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(httpStatus)
+	w.Write(data)
+	w.Write([]byte("\n"))
+}
+
+// WriteRestError writes a grpc error as a google.rpc.Status JSON response with the corresponding HTTP status.
+func WriteRestError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	WriteRestResponse(w, HttpStatusFromCode(st.Code()), st.Proto())
+}
+
+// HttpStatusFromCode returns the HTTP status for a grpc status code.
+func HttpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Canceled:
+		return 499
+	}
+	return http.StatusInternalServerError
+}
+
+// RestAdminToken returns the bearer token in the Authorization header of a REST request, or empty if not set.
+func RestAdminToken(r *http.Request) string {
+	if value := r.Header.Get("Authorization"); strings.HasPrefix(value, "Bearer ") {
+		return strings.TrimPrefix(value, "Bearer ")
+	}
+	return ""
+}
+
+// CheckRestAdminLocked checks that a REST request has the admin token as bearer authorization. s.lock must be held
+// while calling this function.
+func (s *Server) CheckRestAdminLocked(r *http.Request, action string) error {
+	if !s.IsAdminTokenValid(RestAdminToken(r)) {
+		log.Printf("User admin@%s: *** Rejected REST %s request with invalid admin token", r.RemoteAddr, action)
+		return status.Errorf(codes.PermissionDenied, "invalid admin token")
+	}
+	return nil
+}
+
+// CheckRestUserLocked checks that a REST edit names the user making it and that the user is allowed to connect.
+// s.lock must be held while calling this function.
+func (s *Server) CheckRestUserLocked(userName string) error {
+	if userName == "" {
+		return status.Errorf(codes.InvalidArgument, "user_name must be set")
+	}
+	return s.CheckUserAdmittedLocked(userName, time.Now())
+}
+
+// HandleRestQueryUsers returns the users that have sent updates and not timed out, ordered by user name.
+func (s *Server) HandleRestQueryUsers() *pb.QueryUsersResponse {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resp := s.HandleQueryUsersLocked("", &pb.QueryUsersRequest{})
+	sort.Slice(resp.Results, func(i, j int) bool {
+		return resp.Results[i].UserName < resp.Results[j].UserName
+	})
+	return resp
+}
+
+// HandleRestTextNoteAdd adds or modifies a text note on an anchor for a user, generating an id for new text notes
+// without one, and returns the stored text note.
+func (s *Server) HandleRestTextNoteAdd(userName string, anchorId string, textNote *pb.TextNoteProto) (*pb.TextNoteProto, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.CheckRestUserLocked(userName); err != nil {
+		return nil, err
+	}
+	anchorState, ok := s.anchorStateMap[anchorId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "anchor %s not found", anchorId)
+	}
+	if len(textNote.Text) > maxTextNoteLength {
+		return nil, status.Errorf(codes.InvalidArgument, "text length %d is longer than %d",
+			len(textNote.Text), maxTextNoteLength)
+	}
+	if textNote.Id == "" {
+		textNote.Id = NewRestContentId()
+	} else if anchorState.HasContent(textNote.Id) {
+		if _, ok := anchorState.textNotes[textNote.Id]; !ok {
+			return nil, status.Errorf(codes.AlreadyExists, "content %s is not a text note", textNote.Id)
+		}
+	}
+	textNote.AnchorId = anchorId
+
+	// The text note is replaced with a new copy unless the edit was rejected.
+	previous := anchorState.textNotes[textNote.Id]
+	s.HandleTextNoteAddLocked(userName, &pb.TextNoteAddRequest{TextNote: textNote}, false)
+	stored := anchorState.textNotes[textNote.Id]
+	if stored == nil || stored == previous {
+		return nil, status.Errorf(codes.FailedPrecondition, "text note %s is in a locked layer", textNote.Id)
+	}
+	log.Printf("User %s: Added or updated text note %s on anchor %s over REST", userName, textNote.Id, anchorId)
+	return stored, nil
+}
+
+// HandleRestTextNoteRemove removes a text note from an anchor for a user.
+func (s *Server) HandleRestTextNoteRemove(userName string, anchorId string, textNoteId string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.CheckRestUserLocked(userName); err != nil {
+		return err
+	}
+	anchorState, ok := s.anchorStateMap[anchorId]
+	if !ok {
+		return status.Errorf(codes.NotFound, "anchor %s not found", anchorId)
+	}
+	if _, ok := anchorState.textNotes[textNoteId]; !ok {
+		return status.Errorf(codes.NotFound, "text note %s not found", textNoteId)
+	}
+
+	s.HandleTextNoteRemoveLocked(userName, &pb.TextNoteRemoveRequest{Id: textNoteId, AnchorId: anchorId}, false)
+	if _, ok := anchorState.textNotes[textNoteId]; ok {
+		return status.Errorf(codes.FailedPrecondition, "text note %s is in a locked layer", textNoteId)
+	}
+	log.Printf("User %s: Removed text note %s from anchor %s over REST", userName, textNoteId, anchorId)
+	return nil
+}
+
+// HandleRestClearContent removes the content matching a filter that was created by a user, or by any user if
+// adminToken is set.
+func (s *Server) HandleRestClearContent(userName string, adminToken string, filter *pb.ClearContentRequest) (*pb.ClearContentResponse, error) {
+	if adminToken != "" {
+		if userName == "" {
+			userName = "admin"
+		}
+		resp, err := s.Rpc(context.Background(), &pb.RpcRequest{UserName: userName,
+			AdminClearContentRequest: &pb.AdminClearContentRequest{AdminToken: adminToken, Filter: filter}})
+		if err != nil {
+			return nil, err
+		}
+		return resp.ClearContentResponse, nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.CheckRestUserLocked(userName); err != nil {
+		return nil, err
+	}
+	return s.HandleClearContentLocked(userName, filter)
+}
+
+// NewRestContentId returns a new random id for content added over REST.
+func NewRestContentId() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		log.Fatalf("Failed to generate random id: %v", err)
+	}
+	return hex.EncodeToString(id)
+}
@@ -2,25 +2,28 @@ package main
 
 import (
 	context "context"
+	"crypto/subtle"
+	"fmt"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"log"
-	"sync"
+	"sync/atomic"
 	"time"
 
 	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
 )
 
 const (
-	// Interval between running periodic cleanup checks
-	periodicChecksInterval = time.Second
+	// Default interval between running periodic cleanup checks
+	defaultPeriodicChecksInterval = time.Second
 
-	// Timeout for removing a user who has not sent updates in this period.
-	userTimeout = 10 * time.Second
+	// Default timeout for removing a user who has not sent updates in this period.
+	defaultUserTimeout = 10 * time.Second
 
-	// Interval for periodic connection health pings from server to client
-	periodicServerToClientPingInterval = 1 * time.Second
+	// Default interval for periodic connection health pings from server to client
+	defaultServerToClientPingInterval = 1 * time.Second
 )
 
 // UserState represents th ttate for each user currently connected and uploading data
@@ -33,11 +36,22 @@ type UserState struct {
 	stateProto *pb.UserStateProto
 	// Latest Space information for ths user
 	spaceInfoProto *pb.SpaceInfoProto
+	// Whether the user's headset battery was low in the latest state, to publish an event only when it becomes low
+	batteryLow bool
 }
 
 func (u *UserState) Init() {
 }
 
+// ContentInfo contains server-side metadata for a brush stroke, 3D model, text note, primitive or measurement attached
+// to an anchor
+type ContentInfo struct {
+	// The user identifier for the user who created this content.
+	createdByUserName string
+	// Time when the server first received this content.
+	createTime time.Time
+}
+
 // AnchorState represents the state for a Spatial Anchor
 type AnchorState struct {
 	// The spatial anchor identifier
@@ -48,12 +62,58 @@ type AnchorState struct {
 	brushStrokes map[string]*pb.BrushStrokeProto
 	// Map of External 3D Models attached to this spatial anchor. Key is model id.
 	externalModels map[string]*pb.ExternalModelProto
+	// Map of text notes attached to this spatial anchor. Key is text note id.
+	textNotes map[string]*pb.TextNoteProto
+	// Map of geometric primitives attached to this spatial anchor. Key is primitive id.
+	primitives map[string]*pb.PrimitiveProto
+	// Map of measurements attached to this spatial anchor. Key is measurement id.
+	measurements map[string]*pb.MeasurementProto
+	// Map of server-side metadata for all content attached to this spatial anchor. Key is brush stroke, model, text
+	// note, primitive or measurement id.
+	contentInfo map[string]*ContentInfo
+	// Map of groups attached to this spatial anchor. Key is group id.
+	contentGroups map[string]*pb.ContentGroupProto
+	// Map from brush stroke, 3D model, text note, primitive or measurement id to the id of the group it belongs to.
+	contentGroupIds map[string]string
+	// Map of comments on content attached to this spatial anchor. Key is comment id.
+	comments map[string]*pb.CommentProto
+	// Map of grab locks held on brush strokes and 3D models attached to this spatial anchor. Key is content id.
+	grabLocks map[string]*pb.GrabLockProto
 }
 
 func (a *AnchorState) Init() {
 	a.userSet = make(map[string]bool)
 	a.brushStrokes = make(map[string]*pb.BrushStrokeProto)
 	a.externalModels = make(map[string]*pb.ExternalModelProto)
+	a.textNotes = make(map[string]*pb.TextNoteProto)
+	a.primitives = make(map[string]*pb.PrimitiveProto)
+	a.measurements = make(map[string]*pb.MeasurementProto)
+	a.contentInfo = make(map[string]*ContentInfo)
+	a.contentGroups = make(map[string]*pb.ContentGroupProto)
+	a.contentGroupIds = make(map[string]string)
+	a.comments = make(map[string]*pb.CommentProto)
+	a.grabLocks = make(map[string]*pb.GrabLockProto)
+}
+
+// HasContent checks whether a brush stroke, 3D model, text note, primitive or measurement with the provided id is
+// attached to this anchor.
+func (a *AnchorState) HasContent(contentId string) bool {
+	if _, ok := a.brushStrokes[contentId]; ok {
+		return true
+	}
+	if _, ok := a.externalModels[contentId]; ok {
+		return true
+	}
+	if _, ok := a.textNotes[contentId]; ok {
+		return true
+	}
+	if _, ok := a.primitives[contentId]; ok {
+		return true
+	}
+	if _, ok := a.measurements[contentId]; ok {
+		return true
+	}
+	return false
 }
 
 // UserBrushStrokeState represents the state of a particular connected user who has received a
@@ -71,6 +131,10 @@ type UserConnectionState struct {
 	userName string
 	// The client application version string.
 	appVersion string
+	// Time when the listening stream connected.
+	connectTime time.Time
+	// The error to return to the client if this connection was shut down by an admin, or nil.
+	kickError error
 	// A channel to trigger shutdown of this connection.
 	shutDownStart chan bool
 	// A channel to receive when shutdown of this channel has completed before a new one starts.
@@ -85,14 +149,63 @@ type UserConnectionState struct {
 	// Set of brush strokes that have been removed that this user needs to be notified about.
 	// Key is brush stroke id, value is attached anchor id.
 	notifyAboutBrushStrokeRemovals map[string]string
+	// Set of brush strokes that have been transformed that this user needs to be notified about.
+	// Key is brush stroke id, value is attached anchor id.
+	notifyAboutBrushStrokeTransforms map[string]string
 	// Set of 3D models that have been added or modified that this user needs to be notified about.
 	// Key is external model id, value is attached anchor id.
 	notifyAboutExternalModelAdds map[string]string
 	// Set of 3D models that have been removed that this user needs to be notified about.
 	// Key is external model id, value is attached anchor id.
 	notifyAboutExternalModelRemovals map[string]string
+	// Set of text notes that have been added or modified that this user needs to be notified about.
+	// Key is text note id, value is attached anchor id.
+	notifyAboutTextNoteAdds map[string]string
+	// Set of text notes that have been removed that this user needs to be notified about.
+	// Key is text note id, value is attached anchor id.
+	notifyAboutTextNoteRemovals map[string]string
+	// Set of primitives that have been added or modified that this user needs to be notified about.
+	// Key is primitive id, value is attached anchor id.
+	notifyAboutPrimitiveAdds map[string]string
+	// Set of primitives that have been removed that this user needs to be notified about.
+	// Key is primitive id, value is attached anchor id.
+	notifyAboutPrimitiveRemovals map[string]string
+	// Set of measurements that have been added or modified that this user needs to be notified about.
+	// Key is measurement id, value is attached anchor id.
+	notifyAboutMeasurementAdds map[string]string
+	// Set of measurements that have been removed that this user needs to be notified about.
+	// Key is measurement id, value is attached anchor id.
+	notifyAboutMeasurementRemovals map[string]string
+	// Set of groups that have been added or modified that this user needs to be notified about.
+	// Key is group id, value is attached anchor id.
+	notifyAboutContentGroupAdds map[string]string
+	// Set of groups that have been removed that this user needs to be notified about.
+	// Key is group id, value is attached anchor id.
+	notifyAboutContentGroupRemovals map[string]string
+	// Set of comments that have been added or modified that this user needs to be notified about.
+	// Key is comment id, value is attached anchor id.
+	notifyAboutCommentAdds map[string]string
+	// Set of comments that have been removed that this user needs to be notified about.
+	// Key is comment id, value is attached anchor id.
+	notifyAboutCommentRemovals map[string]string
+	// Set of grab locks that have been acquired or renewed that this user needs to be notified about.
+	// Key is content id, value is attached anchor id.
+	notifyAboutGrabLockAdds map[string]string
+	// Set of grab locks that have been released that this user needs to be notified about.
+	// Key is content id, value is attached anchor id.
+	notifyAboutGrabLockRemovals map[string]string
+	// Whether the layer list has changed and this user needs to be notified about it.
+	notifyAboutLayers bool
+	// Whether this user needs to be sent the room settings.
+	notifyAboutRoomSettings bool
+	// Whether this user needs to be sent the shutdown notice.
+	notifyAboutShutdown bool
+	// List of broadcast messages that this user needs to be sent.
+	notifyAboutBroadcasts []*pb.BroadcastMessageProto
 	// A channel to trigger the wake-up of this connection if it was sleeping for work to do.
 	wakeUp chan bool
+	// Total size in bytes of server state responses sent on this connection. Updated atomically.
+	bytesSent uint64
 }
 
 func (u *UserConnectionState) Init() {
@@ -102,25 +215,56 @@ func (u *UserConnectionState) Init() {
 	u.notifyAboutUsers = make(map[string]bool)
 	u.notifyAboutBrushStrokeAdds = make(map[string]string)
 	u.notifyAboutBrushStrokeRemovals = make(map[string]string)
+	u.notifyAboutBrushStrokeTransforms = make(map[string]string)
 	u.notifyAboutExternalModelAdds = make(map[string]string)
 	u.notifyAboutExternalModelRemovals = make(map[string]string)
+	u.notifyAboutTextNoteAdds = make(map[string]string)
+	u.notifyAboutTextNoteRemovals = make(map[string]string)
+	u.notifyAboutPrimitiveAdds = make(map[string]string)
+	u.notifyAboutPrimitiveRemovals = make(map[string]string)
+	u.notifyAboutMeasurementAdds = make(map[string]string)
+	u.notifyAboutMeasurementRemovals = make(map[string]string)
+	u.notifyAboutContentGroupAdds = make(map[string]string)
+	u.notifyAboutContentGroupRemovals = make(map[string]string)
+	u.notifyAboutCommentAdds = make(map[string]string)
+	u.notifyAboutCommentRemovals = make(map[string]string)
+	u.notifyAboutGrabLockAdds = make(map[string]string)
+	u.notifyAboutGrabLockRemovals = make(map[string]string)
+	u.notifyAboutLayers = true
+	u.notifyAboutRoomSettings = true
 	u.wakeUp = make(chan bool, 1)
 }
 
-// Server implements the leap brush api and stores brush strokes, 3d models, and user state, with dispatch
+// Server implements the leap brush api and stores brush strokes, 3d models, text notes, primitives, measurements, and
+// user state, with dispatch
 type Server struct {
 	pb.UnimplementedLeapBrushApiServer
 
-	// Whether this server should log verbosely.
-	verbose bool
+	// The current configuration, a *Config that is replaced as a whole when the config file is reloaded.
+	config atomic.Value
+	// The unit system for measurements until it is changed by a user.
+	defaultUnitSystem pb.RoomSettingsProto_UnitSystem
+	// The store for uploaded 3D model files.
+	assetStore *AssetStore
+	// The standard grpc health service reporting whether this server is serving.
+	healthServer *health.Server
+	// Whether the server is ready to serve clients, 1 if serving. Updated atomically.
+	serving int32
 
 	// A channel to notify that periodic checks should stop.
 	periodicChecksShutDownStart chan bool
 	// A channel to notify that ths periodic checks have stopped.
 	periodicChecksShutDownDone chan bool
 
+	// Counters and histograms for server traffic.
+	metrics ServerMetrics
+
 	// Lock to protect cross-thread accessed data
-	lock sync.Mutex
+	lock MeteredMutex
+	// Whether this server should log verbosely.
+	verbose bool
+	// The token required for admin requests. Admin requests are rejected if empty.
+	adminToken string
 	// Whether this server is shutting down.
 	shutDown bool
 	// Map from user identifier to current user state.
@@ -129,6 +273,21 @@ type Server struct {
 	anchorStateMap map[string]*AnchorState
 	// Map from user identifier to connection state.
 	userConnectionsMap map[string]*UserConnectionState
+	// Map from layer id to layer.
+	layers map[string]*pb.LayerProto
+	// Map from user identifier to the user's layer visibility overrides. Key of the inner map is layer id.
+	layerVisibilityOverrides map[string]map[string]bool
+	// The settings shared by all users.
+	roomSettings *pb.RoomSettingsProto
+	// The notice sent to clients once the server starts shutting down, or nil.
+	shutdownNotice *pb.ShutdownNoticeProto
+	// Map from user identifier to the reason given for banning the user.
+	bannedUsers map[string]string
+	// Map from user identifier to the time the user was last kicked. Streams that the user opened before then are
+	// rejected.
+	kickTimes map[string]time.Time
+	// Set of subscribers to user and content events.
+	eventSubscribers map[*EventSubscriber]bool
 }
 
 // InitAndStart initializes and starts the server
@@ -136,20 +295,51 @@ func (s *Server) InitAndStart() {
 	s.userStateMap = make(map[string]*UserState)
 	s.anchorStateMap = make(map[string]*AnchorState)
 	s.userConnectionsMap = make(map[string]*UserConnectionState)
+	s.layers = make(map[string]*pb.LayerProto)
+	s.layerVisibilityOverrides = make(map[string]map[string]bool)
+	s.bannedUsers = make(map[string]string)
+	s.kickTimes = make(map[string]time.Time)
+	s.eventSubscribers = make(map[*EventSubscriber]bool)
+	s.roomSettings = &pb.RoomSettingsProto{UnitSystem: s.defaultUnitSystem}
 	s.periodicChecksShutDownStart = make(chan bool, 1)
 	s.periodicChecksShutDownDone = make(chan bool)
+	s.metrics.sendLatencySeconds.Init(sendLatencyBuckets)
+	s.lock.waitSeconds.Init(lockWaitBuckets)
 
 	go s.PeriodicChecks()
 }
 
+// NotifyShutdown stops accepting new clients and sends all connected clients a notice that the server will shut down
+// by shutdownTime, with an optional address to reconnect to.
+func (s *Server) NotifyShutdown(shutdownTime time.Time, reconnectAddress string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.SetServing(false)
+	s.shutdownNotice = &pb.ShutdownNoticeProto{
+		ShutdownTimeMillis: shutdownTime.UnixMilli(),
+		ReconnectAddress:   reconnectAddress,
+	}
+	for _, userConnectionEntry := range s.userConnectionsMap {
+		userConnectionEntry.notifyAboutShutdown = true
+		select {
+		case userConnectionEntry.wakeUp <- true:
+		default:
+		}
+	}
+	log.Printf("Notified %d connected users of shutdown", len(s.userConnectionsMap))
+}
+
 // ShutDown initiates and waits for server shutdown
 func (s *Server) ShutDown() {
 	func() {
 		s.lock.Lock()
 		defer s.lock.Unlock()
 
+		s.SetServing(false)
 		s.shutDown = true
 		s.periodicChecksShutDownStart <- true
+		s.CloseEventSubscribersLocked()
 
 		for _, userConnectionEntry := range s.userConnectionsMap {
 			select {
@@ -170,7 +360,7 @@ func (s *Server) PeriodicChecks() {
 			log.Print("Periodic checks shutting down...")
 			s.periodicChecksShutDownDone <- true
 			return
-		case <-time.After(periodicChecksInterval):
+		case <-time.After(s.Config().PeriodicChecksInterval):
 			break
 		}
 
@@ -183,7 +373,7 @@ func (s *Server) PeriodicChecks() {
 
 			// Look for and clean up users that have not sent updates in a while.
 			for userName, userState := range s.userStateMap {
-				if now.After(userState.lastPingTime.Add(userTimeout)) {
+				if now.After(userState.lastPingTime.Add(s.Config().UserTimeout)) {
 					if timedOutUsers == nil {
 						timedOutUsers = make([]string, 0, len(s.userStateMap))
 					}
@@ -194,9 +384,21 @@ func (s *Server) PeriodicChecks() {
 			if timedOutUsers != nil {
 				for _, userName := range timedOutUsers {
 					log.Printf("User %v: Expiring due to timeout", userName)
+					s.PublishEventLocked(pb.ServerEventProto_USER_LEFT, userName, "", "", "timed out")
+					atomic.AddUint64(&s.metrics.userTimeouts, 1)
 					delete(s.userStateMap, userName)
 				}
 			}
+
+			// Release grab locks that have expired or whose holder timed out.
+			s.ExpireGrabLocksLocked(now)
+
+			// Forget kicks once any stream the user opened before the kick would have timed out.
+			for userName, kickTime := range s.kickTimes {
+				if now.After(kickTime.Add(s.Config().UserTimeout)) {
+					delete(s.kickTimes, userName)
+				}
+			}
 		}()
 	}
 }
@@ -204,6 +406,13 @@ func (s *Server) PeriodicChecks() {
 // RegisterAndListen handles the download connection from a client and sends a stream of server updates when
 // information changes that that client should be notified about.
 func (s *Server) RegisterAndListen(req *pb.RegisterDeviceRequest, listenServer pb.LeapBrushApi_RegisterAndListenServer) error {
+	return s.Listen(req, listenServer.Send)
+}
+
+// Listen registers a listening connection for a user and calls send with each server update for that user, until the
+// connection is shut down or send fails. Shared by grpc clients and web spectators.
+func (s *Server) Listen(req *pb.RegisterDeviceRequest, send func(*pb.ServerStateResponse) error) error {
+	var err error
 	func() {
 		var userConnectionEntry *UserConnectionState
 		var existingEntryFound bool
@@ -215,8 +424,15 @@ func (s *Server) RegisterAndListen(req *pb.RegisterDeviceRequest, listenServer p
 			defer s.lock.Unlock()
 
 			userName = req.UserName
+			if err = s.CheckUserAdmittedLocked(userName, time.Now()); err != nil {
+				log.Printf("User %v: *** Rejected listening channel: %v", userName, err)
+				return
+			}
 			userConnectionEntry, existingEntryFound = s.userConnectionsMap[userName]
 		}()
+		if err != nil {
+			return
+		}
 
 		// If an existing connection state is present for this user, shut it down and wait for it to exit
 		// before continuing with the new connection.
@@ -231,16 +447,24 @@ func (s *Server) RegisterAndListen(req *pb.RegisterDeviceRequest, listenServer p
 			s.lock.Lock()
 			defer s.lock.Unlock()
 
-			userConnectionEntry = &UserConnectionState{userName: userName, appVersion: req.AppVersion}
+			userConnectionEntry = &UserConnectionState{userName: userName, appVersion: req.AppVersion,
+				connectTime: time.Now()}
 			userConnectionEntry.Init()
 			s.userConnectionsMap[userName] = userConnectionEntry
 
 			log.Printf("User %v (version %v): Starting listening channel... (%d users now connected)",
 				userName, req.AppVersion, len(s.userConnectionsMap))
+			s.PublishEventLocked(pb.ServerEventProto_USER_CONNECTED, userName, "", "", req.AppVersion)
 
-			// Send the user all brush strokes and 3D models that currently apply.
+			// Send the user all content that currently applies.
 			s.DistributeMissingBrushStrokesToUserLocked(nil, userConnectionEntry)
 			s.DistributeMissingExternalModelsToUserLocked(nil, userConnectionEntry)
+			s.DistributeMissingTextNotesToUserLocked(nil, userConnectionEntry)
+			s.DistributeMissingPrimitivesToUserLocked(nil, userConnectionEntry)
+			s.DistributeMissingMeasurementsToUserLocked(nil, userConnectionEntry)
+			s.DistributeMissingContentGroupsToUserLocked(nil, userConnectionEntry)
+			s.DistributeMissingCommentsToUserLocked(nil, userConnectionEntry)
+			s.DistributeMissingGrabLocksToUserLocked(nil, userConnectionEntry)
 		}()
 
 		// Deferred function to perform cleanup and shutdown
@@ -249,11 +473,13 @@ func (s *Server) RegisterAndListen(req *pb.RegisterDeviceRequest, listenServer p
 			defer s.lock.Unlock()
 
 			delete(s.userConnectionsMap, userName)
+			err = userConnectionEntry.kickError
 
 			userConnectionEntry.shutDownDone <- true
 
 			log.Printf("User %v: Listening channel shut down (%d users now connected)",
 				userName, len(s.userConnectionsMap))
+			s.PublishEventLocked(pb.ServerEventProto_USER_DISCONNECTED, userName, "", "", "")
 		}()
 
 		// Loop until the connection (download) thread should shut down.
@@ -266,7 +492,7 @@ func (s *Server) RegisterAndListen(req *pb.RegisterDeviceRequest, listenServer p
 			case <-userConnectionEntry.wakeUp:
 				// This connection should wake up to process a pending state event
 				break
-			case <-time.After(periodicServerToClientPingInterval):
+			case <-time.After(s.Config().ServerToClientPingInterval):
 				// This connection should wake up to send a periodic ping to the client.
 				break
 			}
@@ -275,7 +501,8 @@ func (s *Server) RegisterAndListen(req *pb.RegisterDeviceRequest, listenServer p
 
 			// Send server info to the client one time
 			if !sentServerInfo {
-				serverStateResponse.ServerInfo = &pb.ServerInfoProto{ServerVersion: serverVersion, MinAppVersion: minAppVersion}
+				serverStateResponse.ServerInfo = &pb.ServerInfoProto{ServerVersion: serverVersion,
+					MinAppVersion: s.Config().MinAppVersion}
 				sentServerInfo = true
 			}
 
@@ -355,6 +582,28 @@ func (s *Server) RegisterAndListen(req *pb.RegisterDeviceRequest, listenServer p
 				}
 				userConnectionEntry.notifyAboutBrushStrokeRemovals = make(map[string]string)
 
+				// Include in the response the latest transform for every brush stroke that this user has already
+				// been sent and hasn't been notified about yet. Brush strokes not yet sent will include the latest
+				// transform when they are first sent.
+				for brushId, anchorId := range userConnectionEntry.notifyAboutBrushStrokeTransforms {
+					if userBrushStrokeState, ok := userConnectionEntry.brushStrokeState[brushId]; !ok ||
+						userBrushStrokeState.numPosesSent == 0 {
+						continue
+					}
+					if anchorState, ok := s.anchorStateMap[anchorId]; ok {
+						if brushState, ok := anchorState.brushStrokes[brushId]; ok {
+							serverStateResponse.BrushStrokeTransform = append(
+								serverStateResponse.BrushStrokeTransform, &pb.BrushStrokeTransformRequest{
+									Id: brushId, AnchorId: anchorId, Transform: brushState.Transform,
+									ParentId: proto.String(brushState.ParentId)})
+							if s.verbose {
+								log.Printf("User %s: Sending brush stroke transform for %v", userName, brushId)
+							}
+						}
+					}
+				}
+				userConnectionEntry.notifyAboutBrushStrokeTransforms = make(map[string]string)
+
 				// Include in the response all added or modified external 3d model states that the user hasn't been
 				// notified about yet.
 				for modelId, anchorId := range userConnectionEntry.notifyAboutExternalModelAdds {
@@ -383,29 +632,230 @@ func (s *Server) RegisterAndListen(req *pb.RegisterDeviceRequest, listenServer p
 					}
 				}
 				userConnectionEntry.notifyAboutExternalModelRemovals = make(map[string]string)
+
+				// Include in the response all added or modified text notes that the user hasn't been notified about
+				// yet.
+				for textNoteId, anchorId := range userConnectionEntry.notifyAboutTextNoteAdds {
+					if anchorState, ok := s.anchorStateMap[anchorId]; ok {
+						if textNote, ok := anchorState.textNotes[textNoteId]; ok {
+							if s.verbose {
+								log.Printf("User %s: Sending text note %v update from %v",
+									userName, textNote.Id, textNote.ModifiedByUserName)
+							}
+							serverStateResponse.TextNoteAdd = append(
+								serverStateResponse.TextNoteAdd,
+								&pb.TextNoteAddRequest{TextNote: textNote})
+						}
+					}
+				}
+				userConnectionEntry.notifyAboutTextNoteAdds = make(map[string]string)
+
+				// Include in the response every text note remove event that this user hasn't been notified about yet.
+				for textNoteId, anchorId := range userConnectionEntry.notifyAboutTextNoteRemovals {
+					serverStateResponse.TextNoteRemove = append(
+						serverStateResponse.TextNoteRemove, &pb.TextNoteRemoveRequest{
+							Id: textNoteId, AnchorId: anchorId})
+					if s.verbose {
+						log.Printf("User %s: Sending text note remove for %v", userName, textNoteId)
+					}
+				}
+				userConnectionEntry.notifyAboutTextNoteRemovals = make(map[string]string)
+
+				// Include in the response all added or modified primitives that the user hasn't been notified about
+				// yet.
+				for primitiveId, anchorId := range userConnectionEntry.notifyAboutPrimitiveAdds {
+					if anchorState, ok := s.anchorStateMap[anchorId]; ok {
+						if primitive, ok := anchorState.primitives[primitiveId]; ok {
+							if s.verbose {
+								log.Printf("User %s: Sending primitive %v (%v) update from %v",
+									userName, primitive.Id, primitive.Shape, primitive.ModifiedByUserName)
+							}
+							serverStateResponse.PrimitiveAdd = append(
+								serverStateResponse.PrimitiveAdd,
+								&pb.PrimitiveAddRequest{Primitive: primitive})
+						}
+					}
+				}
+				userConnectionEntry.notifyAboutPrimitiveAdds = make(map[string]string)
+
+				// Include in the response every primitive remove event that this user hasn't been notified about yet.
+				for primitiveId, anchorId := range userConnectionEntry.notifyAboutPrimitiveRemovals {
+					serverStateResponse.PrimitiveRemove = append(
+						serverStateResponse.PrimitiveRemove, &pb.PrimitiveRemoveRequest{
+							Id: primitiveId, AnchorId: anchorId})
+					if s.verbose {
+						log.Printf("User %s: Sending primitive remove for %v", userName, primitiveId)
+					}
+				}
+				userConnectionEntry.notifyAboutPrimitiveRemovals = make(map[string]string)
+
+				// Include in the response all added or modified measurements that the user hasn't been notified about
+				// yet.
+				for measurementId, anchorId := range userConnectionEntry.notifyAboutMeasurementAdds {
+					if anchorState, ok := s.anchorStateMap[anchorId]; ok {
+						if measurement, ok := anchorState.measurements[measurementId]; ok {
+							if s.verbose {
+								log.Printf("User %s: Sending measurement %v (%v) update from %v",
+									userName, measurement.Id, measurement.TotalLengthLabel,
+									measurement.ModifiedByUserName)
+							}
+							serverStateResponse.MeasurementAdd = append(
+								serverStateResponse.MeasurementAdd,
+								&pb.MeasurementAddRequest{Measurement: measurement})
+						}
+					}
+				}
+				userConnectionEntry.notifyAboutMeasurementAdds = make(map[string]string)
+
+				// Include in the response every measurement remove event that this user hasn't been notified about
+				// yet.
+				for measurementId, anchorId := range userConnectionEntry.notifyAboutMeasurementRemovals {
+					serverStateResponse.MeasurementRemove = append(
+						serverStateResponse.MeasurementRemove, &pb.MeasurementRemoveRequest{
+							Id: measurementId, AnchorId: anchorId})
+					if s.verbose {
+						log.Printf("User %s: Sending measurement remove for %v", userName, measurementId)
+					}
+				}
+				userConnectionEntry.notifyAboutMeasurementRemovals = make(map[string]string)
+
+				// Include in the response all added or modified groups that the user hasn't been notified about yet.
+				for groupId, anchorId := range userConnectionEntry.notifyAboutContentGroupAdds {
+					if anchorState, ok := s.anchorStateMap[anchorId]; ok {
+						if group, ok := anchorState.contentGroups[groupId]; ok {
+							if s.verbose {
+								log.Printf("User %s: Sending group %v (%v) update from %v",
+									userName, group.Id, group.Name, group.ModifiedByUserName)
+							}
+							serverStateResponse.ContentGroupAdd = append(
+								serverStateResponse.ContentGroupAdd,
+								&pb.ContentGroupAddRequest{Group: group})
+						}
+					}
+				}
+				userConnectionEntry.notifyAboutContentGroupAdds = make(map[string]string)
+
+				// Include in the response every group remove event that this user hasn't been notified about yet.
+				for groupId, anchorId := range userConnectionEntry.notifyAboutContentGroupRemovals {
+					serverStateResponse.ContentGroupRemove = append(
+						serverStateResponse.ContentGroupRemove, &pb.ContentGroupRemoveRequest{
+							Id: groupId, AnchorId: anchorId})
+					if s.verbose {
+						log.Printf("User %s: Sending group remove for %v", userName, groupId)
+					}
+				}
+				userConnectionEntry.notifyAboutContentGroupRemovals = make(map[string]string)
+
+				// Include in the response all added or modified comments that the user hasn't been notified about yet.
+				for commentId, anchorId := range userConnectionEntry.notifyAboutCommentAdds {
+					if anchorState, ok := s.anchorStateMap[anchorId]; ok {
+						if comment, ok := anchorState.comments[commentId]; ok {
+							if s.verbose {
+								log.Printf("User %s: Sending comment %v on %v from %v",
+									userName, comment.Id, comment.ContentId, comment.AuthorUserName)
+							}
+							serverStateResponse.CommentAdd = append(
+								serverStateResponse.CommentAdd,
+								&pb.CommentAddRequest{Comment: comment})
+						}
+					}
+				}
+				userConnectionEntry.notifyAboutCommentAdds = make(map[string]string)
+
+				// Include in the response every comment remove event that this user hasn't been notified about yet.
+				for commentId, anchorId := range userConnectionEntry.notifyAboutCommentRemovals {
+					serverStateResponse.CommentRemove = append(
+						serverStateResponse.CommentRemove, &pb.CommentRemoveRequest{
+							Id: commentId, AnchorId: anchorId})
+					if s.verbose {
+						log.Printf("User %s: Sending comment remove for %v", userName, commentId)
+					}
+				}
+				userConnectionEntry.notifyAboutCommentRemovals = make(map[string]string)
+
+				// Include in the response all acquired or renewed grab locks that the user hasn't been notified about
+				// yet.
+				for contentId, anchorId := range userConnectionEntry.notifyAboutGrabLockAdds {
+					if anchorState, ok := s.anchorStateMap[anchorId]; ok {
+						if grabLock, ok := anchorState.grabLocks[contentId]; ok {
+							if s.verbose {
+								log.Printf("User %s: Sending grab lock on %v held by %v",
+									userName, contentId, grabLock.UserName)
+							}
+							serverStateResponse.GrabLockAdd = append(serverStateResponse.GrabLockAdd, grabLock)
+						}
+					}
+				}
+				userConnectionEntry.notifyAboutGrabLockAdds = make(map[string]string)
+
+				// Include in the response every grab lock release that this user hasn't been notified about yet.
+				for contentId, anchorId := range userConnectionEntry.notifyAboutGrabLockRemovals {
+					serverStateResponse.GrabLockRemove = append(
+						serverStateResponse.GrabLockRemove, &pb.GrabLockRequest{
+							ContentId: contentId, AnchorId: anchorId})
+					if s.verbose {
+						log.Printf("User %s: Sending grab lock release for %v", userName, contentId)
+					}
+				}
+				userConnectionEntry.notifyAboutGrabLockRemovals = make(map[string]string)
+
+				// Include in the response the full layer list if it has changed since last update.
+				if userConnectionEntry.notifyAboutLayers {
+					serverStateResponse.LayerList = s.BuildLayerListLocked(userName)
+					userConnectionEntry.notifyAboutLayers = false
+				}
+
+				// Include in the response the room settings if they have changed since last update.
+				if userConnectionEntry.notifyAboutRoomSettings {
+					serverStateResponse.RoomSettings = s.roomSettings
+					userConnectionEntry.notifyAboutRoomSettings = false
+				}
+
+				// Include in the response the shutdown notice if the server has started shutting down.
+				if userConnectionEntry.notifyAboutShutdown {
+					serverStateResponse.ShutdownNotice = s.shutdownNotice
+					userConnectionEntry.notifyAboutShutdown = false
+				}
+
+				// Include in the response every broadcast message that this user hasn't been sent yet.
+				serverStateResponse.BroadcastMessage = userConnectionEntry.notifyAboutBroadcasts
+				userConnectionEntry.notifyAboutBroadcasts = nil
 			}()
 
 			// Send the new server response to the connection stream. It may be empty in the case of a periodic
 			// health check ping.
-			if err := listenServer.Send(serverStateResponse); err != nil {
+			serverStateResponse.ServerTimeMillis = time.Now().UnixMilli()
+			sendStartTime := time.Now()
+			if err := send(serverStateResponse); err != nil {
 				log.Printf("User %v: *** Failed to send server state: %v", userName, err)
+				atomic.AddUint64(&s.metrics.sendFailures, 1)
 				break
 			}
+			s.metrics.sendLatencySeconds.Observe(time.Since(sendStartTime).Seconds())
+			responseSize := uint64(proto.Size(serverStateResponse))
+			atomic.AddUint64(&s.metrics.responsesSent, 1)
+			atomic.AddUint64(&s.metrics.bytesSent, responseSize)
+			atomic.AddUint64(&userConnectionEntry.bytesSent, responseSize)
 		}
 	}()
 
-	return nil
+	return err
 }
 
 // UpdateDeviceStream handles a stream of updates from clients.
 func (s *Server) UpdateDeviceStream(updateServer pb.LeapBrushApi_UpdateDeviceStreamServer) error {
+	streamStartTime := time.Now()
 	for {
 		// Receive the next update message
 		req, err := updateServer.Recv()
 		if err != nil {
 			return err
 		}
-		resp := s.HandleUpdateDevice(req)
+		resp, err := s.HandleUpdateDevice(req, streamStartTime)
+		if err != nil {
+			log.Printf("User %v: *** Rejected update stream: %v", req.UserState.UserName, err)
+			return err
+		}
 		if resp != nil {
 			log.Printf("*** Error: unexpected UpdateDeviceResponse generated: %v", resp)
 			return status.Errorf(codes.Internal, "unexpected UpdateDeviceResponse generated")
@@ -415,26 +865,101 @@ func (s *Server) UpdateDeviceStream(updateServer pb.LeapBrushApi_UpdateDeviceStr
 
 // Rpc handles an out-of-band remote procedure call from a client
 func (s *Server) Rpc(ctx context.Context, req *pb.RpcRequest) (*pb.RpcResponse, error) {
+	resp := &pb.RpcResponse{}
+
+	atomic.AddUint64(&s.metrics.rpcsReceived, 1)
+
+	// The model catalog is read from disk, so it is queried before taking the lock.
+	if req.QueryModelCatalogRequest != nil {
+		var err error
+		if resp.QueryModelCatalogResponse, err = s.HandleQueryModelCatalog(
+			req.UserName, req.QueryModelCatalogRequest); err != nil {
+			return nil, err
+		}
+	}
+
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	resp := &pb.RpcResponse{}
-
 	if req.QueryUsersRequest != nil {
 		resp.QueryUsersResponse = s.HandleQueryUsersLocked(req.UserName, req.QueryUsersRequest)
 	}
 
+	if req.EraseRegionRequest != nil {
+		var err error
+		if resp.EraseRegionResponse, err = s.HandleEraseRegionLocked(req.UserName, req.EraseRegionRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.ClearContentRequest != nil && req.AdminClearContentRequest != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "only one of clear_content_request and "+
+			"admin_clear_content_request may be set")
+	}
+	if req.ClearContentRequest != nil {
+		var err error
+		if resp.ClearContentResponse, err = s.HandleClearContentLocked(req.UserName, req.ClearContentRequest); err != nil {
+			return nil, err
+		}
+	}
+	if req.AdminClearContentRequest != nil {
+		var err error
+		if resp.ClearContentResponse, err = s.HandleAdminClearContentLocked(
+			req.UserName, req.AdminClearContentRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.SetLayerRequest != nil {
+		if err := s.HandleSetLayerLocked(req.UserName, req.SetLayerRequest); err != nil {
+			return nil, err
+		}
+	}
+	if req.RemoveLayerRequest != nil {
+		if err := s.HandleRemoveLayerLocked(req.UserName, req.RemoveLayerRequest); err != nil {
+			return nil, err
+		}
+	}
+	if req.SetLayerVisibilityRequest != nil {
+		if err := s.HandleSetLayerVisibilityLocked(req.UserName, req.SetLayerVisibilityRequest); err != nil {
+			return nil, err
+		}
+	}
+	if req.SetContentLayerRequest != nil {
+		if err := s.HandleSetContentLayerLocked(req.UserName, req.SetContentLayerRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.SetRoomSettingsRequest != nil {
+		if err := s.HandleSetRoomSettingsLocked(req.UserName, req.SetRoomSettingsRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.QueryCommentsRequest != nil {
+		var err error
+		if resp.QueryCommentsResponse, err = s.HandleQueryCommentsLocked(req.UserName, req.QueryCommentsRequest); err != nil {
+			return nil, err
+		}
+	}
+
 	return resp, nil
 }
 
-// HandleUpdateDevice handles a single update device request from a client stream.
-func (s *Server) HandleUpdateDevice(req *pb.UpdateDeviceRequest) *pb.UpdateDeviceResponse {
+// HandleUpdateDevice handles a single update device request from a client stream opened at streamStartTime.
+func (s *Server) HandleUpdateDevice(req *pb.UpdateDeviceRequest, streamStartTime time.Time) (*pb.UpdateDeviceResponse, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
 	var resp *pb.UpdateDeviceResponse = nil
 
+	atomic.AddUint64(&s.metrics.updatesReceived, 1)
+
 	userName := req.UserState.UserName
+	if err := s.CheckUserAdmittedLocked(userName, streamStartTime); err != nil {
+		return nil, err
+	}
 
 	// Create a new entry in userStateMap if this user doesn't have a record yet.
 	userStateEntry, ok := s.userStateMap[userName]
@@ -443,6 +968,7 @@ func (s *Server) HandleUpdateDevice(req *pb.UpdateDeviceRequest) *pb.UpdateDevic
 		userStateEntry = &UserState{userName: userName}
 		userStateEntry.Init()
 		s.userStateMap[userName] = userStateEntry
+		s.PublishEventLocked(pb.ServerEventProto_USER_JOINED, userName, "", "", req.UserState.UserDisplayName)
 	}
 
 	// Note the time the user state was last received in order to time out disconnected clients.
@@ -455,6 +981,15 @@ func (s *Server) HandleUpdateDevice(req *pb.UpdateDeviceRequest) *pb.UpdateDevic
 
 	userStateEntry.stateProto = req.UserState
 
+	batteryLow := req.UserState.HeadsetBattery != nil && IsLowBattery(req.UserState.HeadsetBattery)
+	if batteryLow && !userStateEntry.batteryLow {
+		log.Printf("User %s (%s): Headset battery is low (%d%%)",
+			userName, req.UserState.UserDisplayName, req.UserState.HeadsetBattery.Level)
+		s.PublishEventLocked(pb.ServerEventProto_BATTERY_LOW, userName, "", "",
+			fmt.Sprintf("%d%%", req.UserState.HeadsetBattery.Level))
+	}
+	userStateEntry.batteryLow = batteryLow
+
 	if req.SpaceInfo != nil {
 		if !AnchorIdsEqual(req.SpaceInfo, userStateEntry.spaceInfoProto) {
 			// The user's found anchor ids have changed: remove and re-add the user to the anchor state maps.
@@ -478,6 +1013,12 @@ func (s *Server) HandleUpdateDevice(req *pb.UpdateDeviceRequest) *pb.UpdateDevic
 			// that they haven't recieved yet.
 			s.DistributeMissingBrushStrokesToUserLocked(userStateEntry, nil)
 			s.DistributeMissingExternalModelsToUserLocked(userStateEntry, nil)
+			s.DistributeMissingTextNotesToUserLocked(userStateEntry, nil)
+			s.DistributeMissingPrimitivesToUserLocked(userStateEntry, nil)
+			s.DistributeMissingMeasurementsToUserLocked(userStateEntry, nil)
+			s.DistributeMissingContentGroupsToUserLocked(userStateEntry, nil)
+			s.DistributeMissingCommentsToUserLocked(userStateEntry, nil)
+			s.DistributeMissingGrabLocksToUserLocked(userStateEntry, nil)
 		} else {
 			if s.verbose {
 				log.Printf("User %s (%s): Found anchors updated (no ids changed): %v (space %v: %v)",
@@ -492,9 +1033,20 @@ func (s *Server) HandleUpdateDevice(req *pb.UpdateDeviceRequest) *pb.UpdateDevic
 	// anchors).
 	s.DistributeUserChangesLocked(userStateEntry, req.Echo)
 
+	// Process a grab lock acquired or released by the user before any edits to the grabbed content.
+	if req.GrabLockAcquire != nil {
+		s.HandleGrabLockAcquireLocked(userName, req.GrabLockAcquire)
+	}
+	if req.GrabLockRelease != nil {
+		s.HandleGrabLockReleaseLocked(userName, req.GrabLockRelease)
+	}
+
 	// Process an added or modified brush stroke by the user
 	if req.BrushStrokeAdd != nil {
-		if anchorState, ok := s.anchorStateMap[req.BrushStrokeAdd.BrushStroke.AnchorId]; ok {
+		if anchorState, ok := s.anchorStateMap[req.BrushStrokeAdd.BrushStroke.AnchorId]; ok &&
+			!s.RejectLockedBrushStrokeEditLocked(anchorState, req.BrushStrokeAdd.BrushStroke.Id,
+				req.BrushStrokeAdd.BrushStroke.LayerId, userName) &&
+			!s.RejectInvalidBrushStrokeParentLocked(anchorState, req.BrushStrokeAdd.BrushStroke, userName) {
 			if existingBrushStroke, ok := anchorState.brushStrokes[req.BrushStrokeAdd.BrushStroke.Id]; ok {
 				if req.BrushStrokeAdd.BrushStroke.StartIndex < int32(len(existingBrushStroke.BrushPose)) {
 					existingBrushStroke.BrushPose =
@@ -509,6 +1061,10 @@ func (s *Server) HandleUpdateDevice(req *pb.UpdateDeviceRequest) *pb.UpdateDevic
 					req.BrushStrokeAdd.BrushStroke.StartIndex = 0
 				}
 				anchorState.brushStrokes[req.BrushStrokeAdd.BrushStroke.Id] = req.BrushStrokeAdd.BrushStroke
+				anchorState.contentInfo[req.BrushStrokeAdd.BrushStroke.Id] = &ContentInfo{
+					createdByUserName: userName, createTime: time.Now()}
+				s.PublishEventLocked(pb.ServerEventProto_BRUSH_STROKE_ADDED, userName, anchorState.id,
+					req.BrushStrokeAdd.BrushStroke.Id, "")
 			}
 			s.DistributeBrushStrokeAddLocked(anchorState, req.BrushStrokeAdd.BrushStroke.Id,
 				int(req.BrushStrokeAdd.BrushStroke.StartIndex), userName, req.Echo)
@@ -529,41 +1085,112 @@ func (s *Server) HandleUpdateDevice(req *pb.UpdateDeviceRequest) *pb.UpdateDevic
 
 	// Process a removed brush stroke from the user.
 	if req.BrushStrokeRemove != nil {
-		if anchorState, ok := s.anchorStateMap[req.BrushStrokeRemove.AnchorId]; ok {
-			delete(anchorState.brushStrokes, req.BrushStrokeRemove.Id)
-			s.DistributeBrushStrokeRemoveLocked(anchorState, req.BrushStrokeRemove.Id, userName, req.Echo)
+		if anchorState, ok := s.anchorStateMap[req.BrushStrokeRemove.AnchorId]; ok &&
+			!s.RejectLockedBrushStrokeEditLocked(anchorState, req.BrushStrokeRemove.Id, "", userName) {
+			numDescendantsRemoved := 0
+			if !req.BrushStrokeRemove.KeepChildren {
+				numDescendantsRemoved = s.RemoveContentDescendantsLocked(
+					anchorState, req.BrushStrokeRemove.Id, userName, req.Echo)
+			}
+			s.RemoveBrushStrokeLocked(anchorState, req.BrushStrokeRemove.Id, userName, req.Echo)
 			if s.verbose {
-				log.Printf("User %s: Removed brush stroke %s from anchor %s",
-					userName, req.BrushStrokeRemove.Id, anchorState.id)
+				log.Printf("User %s: Removed brush stroke %s and %d attached items from anchor %s",
+					userName, req.BrushStrokeRemove.Id, numDescendantsRemoved, anchorState.id)
 			}
 		}
 	}
 
-	// Process an added or modified external 3d model from the user.
-	if req.ExternalModelAdd != nil {
-		if anchorState, ok := s.anchorStateMap[req.ExternalModelAdd.Model.AnchorId]; ok {
-			anchorState.externalModels[req.ExternalModelAdd.Model.Id] = req.ExternalModelAdd.Model
-			s.DistributeExternalModelAddLocked(anchorState, req.ExternalModelAdd.Model.Id, userName, req.Echo)
-			if s.verbose {
-				log.Printf("User %s: Create or update model %s (%v) for anchor %s",
-					userName, req.ExternalModelAdd.Model.Id, req.ExternalModelAdd.Model.FileName, anchorState.id)
+	// Process transformed brush strokes from the user.
+	for _, brushStrokeTransform := range req.BrushStrokeTransform {
+		anchorState, ok := s.anchorStateMap[brushStrokeTransform.AnchorId]
+		if !ok {
+			continue
+		}
+		// Transforms without a parent_id keep the existing parent.
+		parentId := anchorState.ContentParentId(brushStrokeTransform.Id)
+		if brushStrokeTransform.ParentId != nil {
+			parentId = *brushStrokeTransform.ParentId
+		}
+		if !s.RejectLockedBrushStrokeEditLocked(anchorState, brushStrokeTransform.Id, "", userName) &&
+			!s.RejectInvalidContentParentLocked(anchorState, brushStrokeTransform.Id, parentId, true, userName) {
+			if brushStroke, ok := anchorState.brushStrokes[brushStrokeTransform.Id]; ok {
+				brushStroke.Transform = brushStrokeTransform.Transform
+				brushStroke.ParentId = parentId
+				s.DistributeBrushStrokeTransformLocked(anchorState, brushStrokeTransform.Id, userName, req.Echo)
+				if s.verbose {
+					log.Printf("User %s: Transformed brush stroke %s for anchor %s",
+						userName, brushStrokeTransform.Id, anchorState.id)
+				}
 			}
 		}
 	}
 
+	// Process an added or modified external 3d model from the user.
+	if req.ExternalModelAdd != nil {
+		s.HandleExternalModelAddLocked(userName, req.ExternalModelAdd, req.Echo)
+	}
+
 	// Process a removed 3d model from the user.
 	if req.ExternalModelRemove != nil {
-		if anchorState, ok := s.anchorStateMap[req.ExternalModelRemove.AnchorId]; ok {
-			delete(anchorState.externalModels, req.ExternalModelRemove.Id)
-			s.DistributeExternalModelRemoveLocked(anchorState, req.ExternalModelRemove.Id, userName, req.Echo)
+		if anchorState, ok := s.anchorStateMap[req.ExternalModelRemove.AnchorId]; ok &&
+			!s.RejectLockedExternalModelEditLocked(anchorState, req.ExternalModelRemove.Id, "", userName) {
+			numDescendantsRemoved := 0
+			if !req.ExternalModelRemove.KeepChildren {
+				numDescendantsRemoved = s.RemoveContentDescendantsLocked(
+					anchorState, req.ExternalModelRemove.Id, userName, req.Echo)
+			}
+			s.RemoveExternalModelLocked(anchorState, req.ExternalModelRemove.Id, userName, req.Echo)
 			if s.verbose {
-				log.Printf("User %s: Removed model %s from anchor %s",
-					userName, req.ExternalModelRemove.Id, anchorState.id)
+				log.Printf("User %s: Removed model %s and %d attached items from anchor %s",
+					userName, req.ExternalModelRemove.Id, numDescendantsRemoved, anchorState.id)
 			}
 		}
 	}
 
-	return resp
+	// Process an added, modified or removed text note from the user.
+	if req.TextNoteAdd != nil {
+		s.HandleTextNoteAddLocked(userName, req.TextNoteAdd, req.Echo)
+	}
+	if req.TextNoteRemove != nil {
+		s.HandleTextNoteRemoveLocked(userName, req.TextNoteRemove, req.Echo)
+	}
+
+	// Process an added, modified or removed primitive from the user.
+	if req.PrimitiveAdd != nil {
+		s.HandlePrimitiveAddLocked(userName, req.PrimitiveAdd, req.Echo)
+	}
+	if req.PrimitiveRemove != nil {
+		s.HandlePrimitiveRemoveLocked(userName, req.PrimitiveRemove, req.Echo)
+	}
+
+	// Process an added, modified or removed measurement from the user.
+	if req.MeasurementAdd != nil {
+		s.HandleMeasurementAddLocked(userName, req.MeasurementAdd, req.Echo)
+	}
+	if req.MeasurementRemove != nil {
+		s.HandleMeasurementRemoveLocked(userName, req.MeasurementRemove, req.Echo)
+	}
+
+	// Process an added, modified or removed comment from the user.
+	if req.CommentAdd != nil {
+		s.HandleCommentAddLocked(userName, req.CommentAdd, req.Echo)
+	}
+	if req.CommentRemove != nil {
+		s.HandleCommentRemoveLocked(userName, req.CommentRemove, req.Echo)
+	}
+
+	// Process an added, modified or removed group from the user.
+	if req.ContentGroupAdd != nil {
+		s.HandleContentGroupAddLocked(userName, req.ContentGroupAdd, req.Echo)
+	}
+	if req.ContentGroupUpdate != nil {
+		s.HandleContentGroupUpdateLocked(userName, req.ContentGroupUpdate, req.Echo)
+	}
+	if req.ContentGroupRemove != nil {
+		s.HandleContentGroupRemoveLocked(userName, req.ContentGroupRemove, req.Echo)
+	}
+
+	return resp, nil
 }
 
 // HandleQueryUsersLocked handles an rpc from a user to fetch the list of other connected users.
@@ -585,6 +1212,273 @@ func (s *Server) HandleQueryUsersLocked(userName string, _ *pb.QueryUsersRequest
 	return resp
 }
 
+// HandleEraseRegionLocked handles an rpc from a user to erase all brush strokes (and optionally 3d models, text notes,
+// primitives and measurements) inside a region of space, distributing the removals to all users including the sender.
+// Content in a locked layer or grabbed by another user is kept. s.lock must be held while calling this function.
+func (s *Server) HandleEraseRegionLocked(userName string, req *pb.EraseRegionRequest) (*pb.EraseRegionResponse, error) {
+	if len(req.Path) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "erase region path must not be empty")
+	}
+	for _, pose := range req.Path {
+		if pose.Position == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "erase region path pose is missing a position")
+		}
+	}
+	if req.Shape == pb.EraseRegionRequest_BOX {
+		if req.BoxHalfExtents == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "erase region box_half_extents must be set")
+		}
+	} else if req.Radius <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "erase region radius must be positive")
+	}
+
+	resp := &pb.EraseRegionResponse{}
+
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok {
+		return resp, nil
+	}
+
+	for brushStrokeId, brushStroke := range anchorState.brushStrokes {
+		if s.IsLayerLockedLocked(brushStroke.LayerId) || anchorState.IsGrabbedByOtherUser(brushStrokeId, userName) {
+			continue
+		}
+		if BrushStrokeInRegion(req, brushStroke, anchorState.ContentAnchorTransform(brushStrokeId)) {
+			resp.RemovedBrushStrokeIds = append(resp.RemovedBrushStrokeIds, brushStrokeId)
+		}
+	}
+	if req.IncludeExternalModels {
+		for modelId, model := range anchorState.externalModels {
+			if s.IsLayerLockedLocked(model.LayerId) || anchorState.IsGrabbedByOtherUser(modelId, userName) {
+				continue
+			}
+			if modelTransform := anchorState.ContentAnchorTransform(modelId); modelTransform != nil &&
+				RegionContains(req, Vec3FromProto(modelTransform.Position)) {
+				resp.RemovedExternalModelIds = append(resp.RemovedExternalModelIds, modelId)
+			}
+		}
+	}
+	if req.IncludeTextNotes {
+		for textNoteId, textNote := range anchorState.textNotes {
+			if s.IsLayerLockedLocked(textNote.LayerId) {
+				continue
+			}
+			if textNote.Pose != nil && RegionContains(req, TransformPoint(anchorState.ContentGroupTransform(textNoteId),
+				Vec3FromProto(textNote.Pose.Position))) {
+				resp.RemovedTextNoteIds = append(resp.RemovedTextNoteIds, textNoteId)
+			}
+		}
+	}
+	if req.IncludePrimitives {
+		for primitiveId, primitive := range anchorState.primitives {
+			if !s.IsLayerLockedLocked(primitive.LayerId) &&
+				PrimitiveInRegion(req, primitive, anchorState.ContentGroupTransform(primitiveId)) {
+				resp.RemovedPrimitiveIds = append(resp.RemovedPrimitiveIds, primitiveId)
+			}
+		}
+	}
+	if req.IncludeMeasurements {
+		for measurementId, measurement := range anchorState.measurements {
+			if !s.IsLayerLockedLocked(measurement.LayerId) &&
+				MeasurementInRegion(req, measurement, anchorState.ContentGroupTransform(measurementId)) {
+				resp.RemovedMeasurementIds = append(resp.RemovedMeasurementIds, measurementId)
+			}
+		}
+	}
+
+	for _, brushStrokeId := range resp.RemovedBrushStrokeIds {
+		s.RemoveBrushStrokeLocked(anchorState, brushStrokeId, userName, true)
+	}
+	for _, modelId := range resp.RemovedExternalModelIds {
+		s.RemoveExternalModelLocked(anchorState, modelId, userName, true)
+	}
+	for _, textNoteId := range resp.RemovedTextNoteIds {
+		s.RemoveTextNoteLocked(anchorState, textNoteId, userName, true)
+	}
+	for _, primitiveId := range resp.RemovedPrimitiveIds {
+		s.RemovePrimitiveLocked(anchorState, primitiveId, userName, true)
+	}
+	for _, measurementId := range resp.RemovedMeasurementIds {
+		s.RemoveMeasurementLocked(anchorState, measurementId, userName, true)
+	}
+
+	log.Printf("User %s: Erased region on anchor %s, removed %d brush strokes, %d models, %d text notes, "+
+		"%d primitives and %d measurements", userName, anchorState.id, len(resp.RemovedBrushStrokeIds),
+		len(resp.RemovedExternalModelIds), len(resp.RemovedTextNoteIds), len(resp.RemovedPrimitiveIds),
+		len(resp.RemovedMeasurementIds))
+
+	return resp, nil
+}
+
+// BrushStrokeInRegion checks whether any segment between consecutive poses of a brush stroke passes through the region
+// described by an EraseRegionRequest. transform is the brush stroke's transform relative to the region's anchor.
+func BrushStrokeInRegion(region *pb.EraseRegionRequest, brushStroke *pb.BrushStrokeProto, transform *pb.TransformProto) bool {
+	if len(brushStroke.BrushPose) == 1 {
+		return RegionContains(region, TransformPoint(transform, Vec3FromProto(brushStroke.BrushPose[0].Position)))
+	}
+	for i := 1; i < len(brushStroke.BrushPose); i++ {
+		if RegionIntersectsSegment(region, TransformPoint(transform, Vec3FromProto(brushStroke.BrushPose[i-1].Position)),
+			TransformPoint(transform, Vec3FromProto(brushStroke.BrushPose[i].Position))) {
+			return true
+		}
+	}
+	return false
+}
+
+// HandleClearContentLocked handles an rpc from a user to remove all content they created that matches a filter.
+// s.lock must be held while calling this function.
+func (s *Server) HandleClearContentLocked(userName string, req *pb.ClearContentRequest) (*pb.ClearContentResponse, error) {
+	if req.UserName != "" && req.UserName != userName {
+		return nil, status.Errorf(codes.PermissionDenied, "clearing content created by other users requires an "+
+			"admin request")
+	}
+
+	filter := proto.Clone(req).(*pb.ClearContentRequest)
+	filter.UserName = userName
+
+	return s.ClearContentLocked(userName, filter, false), nil
+}
+
+// HandleAdminClearContentLocked handles an admin rpc to remove all content created by any user that matches a
+// filter. s.lock must be held while calling this function.
+func (s *Server) HandleAdminClearContentLocked(
+	userName string, req *pb.AdminClearContentRequest) (*pb.ClearContentResponse, error) {
+	if !s.IsAdminTokenValid(req.AdminToken) {
+		log.Printf("User %s: *** Rejected admin clear content request with invalid admin token", userName)
+		return nil, status.Errorf(codes.PermissionDenied, "invalid admin token")
+	}
+
+	filter := req.Filter
+	if filter == nil {
+		filter = &pb.ClearContentRequest{}
+	}
+
+	return s.ClearContentLocked(userName, filter, true), nil
+}
+
+// IsAdminTokenValid checks whether the provided token matches the admin token configured for this server.
+func (s *Server) IsAdminTokenValid(token string) bool {
+	if s.adminToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) == 1
+}
+
+// ClearContentLocked removes all brush strokes, 3d models, text notes, primitives and measurements that match a filter,
+// distributing the removals to all users including the sender. Content in locked layers or grabbed by another user is
+// only removed if ignoreLocks is set. s.lock must be held while calling this function.
+func (s *Server) ClearContentLocked(senderUserName string, filter *pb.ClearContentRequest, ignoreLocks bool) *pb.ClearContentResponse {
+	resp := &pb.ClearContentResponse{}
+
+	now := time.Now()
+	// Content is matched on the creator recorded by the server, rather than the user name set by the client.
+	contentInfoMatches := func(contentInfo *ContentInfo) bool {
+		if filter.UserName != "" && (contentInfo == nil || filter.UserName != contentInfo.createdByUserName) {
+			return false
+		}
+		if filter.OlderThanSeconds != nil {
+			if contentInfo == nil || now.Sub(contentInfo.createTime).Seconds() < float64(*filter.OlderThanSeconds) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for anchorId, anchorState := range s.anchorStateMap {
+		if filter.AnchorId != "" && filter.AnchorId != anchorId {
+			continue
+		}
+
+		var removedBrushStrokeIds []string
+		for brushStrokeId, brushStroke := range anchorState.brushStrokes {
+			if !ignoreLocks && (s.IsLayerLockedLocked(brushStroke.LayerId) ||
+				anchorState.IsGrabbedByOtherUser(brushStrokeId, senderUserName)) {
+				continue
+			}
+			if filter.StrokeColorRgb != nil && *filter.StrokeColorRgb != brushStroke.StrokeColorRgb {
+				continue
+			}
+			if !contentInfoMatches(anchorState.contentInfo[brushStrokeId]) {
+				continue
+			}
+			removedBrushStrokeIds = append(removedBrushStrokeIds, brushStrokeId)
+		}
+
+		var removedModelIds []string
+		var removedTextNoteIds []string
+		var removedPrimitiveIds []string
+		var removedMeasurementIds []string
+		if filter.StrokeColorRgb == nil {
+			for modelId, model := range anchorState.externalModels {
+				if !ignoreLocks && (s.IsLayerLockedLocked(model.LayerId) ||
+					anchorState.IsGrabbedByOtherUser(modelId, senderUserName)) {
+					continue
+				}
+				if !contentInfoMatches(anchorState.contentInfo[modelId]) {
+					continue
+				}
+				removedModelIds = append(removedModelIds, modelId)
+			}
+			for textNoteId, textNote := range anchorState.textNotes {
+				if !ignoreLocks && s.IsLayerLockedLocked(textNote.LayerId) {
+					continue
+				}
+				if !contentInfoMatches(anchorState.contentInfo[textNoteId]) {
+					continue
+				}
+				removedTextNoteIds = append(removedTextNoteIds, textNoteId)
+			}
+			for primitiveId, primitive := range anchorState.primitives {
+				if !ignoreLocks && s.IsLayerLockedLocked(primitive.LayerId) {
+					continue
+				}
+				if !contentInfoMatches(anchorState.contentInfo[primitiveId]) {
+					continue
+				}
+				removedPrimitiveIds = append(removedPrimitiveIds, primitiveId)
+			}
+			for measurementId, measurement := range anchorState.measurements {
+				if !ignoreLocks && s.IsLayerLockedLocked(measurement.LayerId) {
+					continue
+				}
+				if !contentInfoMatches(anchorState.contentInfo[measurementId]) {
+					continue
+				}
+				removedMeasurementIds = append(removedMeasurementIds, measurementId)
+			}
+		}
+
+		for _, brushStrokeId := range removedBrushStrokeIds {
+			s.RemoveBrushStrokeLocked(anchorState, brushStrokeId, senderUserName, true)
+		}
+		for _, modelId := range removedModelIds {
+			s.RemoveExternalModelLocked(anchorState, modelId, senderUserName, true)
+		}
+		for _, textNoteId := range removedTextNoteIds {
+			s.RemoveTextNoteLocked(anchorState, textNoteId, senderUserName, true)
+		}
+		for _, primitiveId := range removedPrimitiveIds {
+			s.RemovePrimitiveLocked(anchorState, primitiveId, senderUserName, true)
+		}
+		for _, measurementId := range removedMeasurementIds {
+			s.RemoveMeasurementLocked(anchorState, measurementId, senderUserName, true)
+		}
+
+		resp.RemovedBrushStrokeIds = append(resp.RemovedBrushStrokeIds, removedBrushStrokeIds...)
+		resp.RemovedExternalModelIds = append(resp.RemovedExternalModelIds, removedModelIds...)
+		resp.RemovedTextNoteIds = append(resp.RemovedTextNoteIds, removedTextNoteIds...)
+		resp.RemovedPrimitiveIds = append(resp.RemovedPrimitiveIds, removedPrimitiveIds...)
+		resp.RemovedMeasurementIds = append(resp.RemovedMeasurementIds, removedMeasurementIds...)
+	}
+
+	log.Printf("User %s: Cleared content matching {%v}, removed %d brush strokes, %d models, %d text notes, "+
+		"%d primitives and %d measurements", senderUserName, filter, len(resp.RemovedBrushStrokeIds),
+		len(resp.RemovedExternalModelIds), len(resp.RemovedTextNoteIds), len(resp.RemovedPrimitiveIds),
+		len(resp.RemovedMeasurementIds))
+
+	return resp
+}
+
 // AnchorIdsEqual checks if the anchor ids are equal between two space info protos.
 func AnchorIdsEqual(spaceInfo1 *pb.SpaceInfoProto, spaceInfo2 *pb.SpaceInfoProto) bool {
 	if (spaceInfo1 == nil) != (spaceInfo2 == nil) {
@@ -663,6 +1557,20 @@ func (s *Server) DistributeBrushStrokeAddLocked(anchorState *AnchorState, brushS
 	}
 }
 
+// RemoveBrushStrokeLocked removes a brush stroke and its comments from an anchor and distributes the removal to other
+// users. Content attached to the brush stroke is reparented to its parent. s.lock must be held while calling this
+// function.
+func (s *Server) RemoveBrushStrokeLocked(anchorState *AnchorState, brushStrokeId string, senderUserName string, echo bool) {
+	s.ReparentContentChildrenLocked(anchorState, brushStrokeId, senderUserName)
+	delete(anchorState.brushStrokes, brushStrokeId)
+	delete(anchorState.contentInfo, brushStrokeId)
+	s.RemoveContentFromGroupLocked(anchorState, brushStrokeId, senderUserName, echo)
+	s.RemoveContentCommentsLocked(anchorState, brushStrokeId, senderUserName, echo)
+	s.ReleaseGrabLockLocked(anchorState, brushStrokeId)
+	s.DistributeBrushStrokeRemoveLocked(anchorState, brushStrokeId, senderUserName, echo)
+	s.PublishEventLocked(pb.ServerEventProto_BRUSH_STROKE_REMOVED, senderUserName, anchorState.id, brushStrokeId, "")
+}
+
 // DistributeBrushStrokeRemoveLocked sets notification bits for user connections, for a brush stroke that was removed.
 // s.lock must be held while calling this function.
 func (s *Server) DistributeBrushStrokeRemoveLocked(anchorState *AnchorState, brushStrokeId string, senderUserName string, echo bool) {
@@ -681,6 +1589,24 @@ func (s *Server) DistributeBrushStrokeRemoveLocked(anchorState *AnchorState, bru
 	}
 }
 
+// DistributeBrushStrokeTransformLocked sets notification bits for user connections, for a brush stroke that was
+// transformed. s.lock must be held while calling this function.
+func (s *Server) DistributeBrushStrokeTransformLocked(anchorState *AnchorState, brushStrokeId string, senderUserName string, echo bool) {
+	for userToNotify, _ := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			userConnectionEntry.notifyAboutBrushStrokeTransforms[brushStrokeId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
 // DistributeMissingBrushStrokesToUserLocked sets notify bits for all brush strokes that a user hasn't been notified
 // about yet, and also cleans up obsolete brush stroke states. s.lock must be held while calling this function.
 func (s *Server) DistributeMissingBrushStrokesToUserLocked(userStateEntry *UserState, userConnectionState *UserConnectionState) {
@@ -740,6 +1666,23 @@ func (s *Server) DistributeExternalModelAddLocked(anchorState *AnchorState, mode
 	}
 }
 
+// RemoveExternalModelLocked removes a 3d model and its comments from an anchor and distributes the removal to other
+// users. Content attached to the 3d model is reparented to its parent. s.lock must be held while calling this
+// function.
+func (s *Server) RemoveExternalModelLocked(anchorState *AnchorState, modelId string, senderUserName string, echo bool) {
+	s.ReparentContentChildrenLocked(anchorState, modelId, senderUserName)
+	if model, ok := anchorState.externalModels[modelId]; ok {
+		s.PublishEventLocked(pb.ServerEventProto_EXTERNAL_MODEL_REMOVED, senderUserName, anchorState.id, modelId,
+			model.FileName)
+	}
+	delete(anchorState.externalModels, modelId)
+	delete(anchorState.contentInfo, modelId)
+	s.RemoveContentFromGroupLocked(anchorState, modelId, senderUserName, echo)
+	s.RemoveContentCommentsLocked(anchorState, modelId, senderUserName, echo)
+	s.ReleaseGrabLockLocked(anchorState, modelId)
+	s.DistributeExternalModelRemoveLocked(anchorState, modelId, senderUserName, echo)
+}
+
 // DistributeExternalModelRemoveLocked sets notification bits for user connections, for a 3d model that was removed.
 // s.lock must be held while calling this function.
 func (s *Server) DistributeExternalModelRemoveLocked(anchorState *AnchorState, modelId string, senderUserName string, echo bool) {
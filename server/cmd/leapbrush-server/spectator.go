@@ -0,0 +1,279 @@
+package main
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Prefix of the generated user identifiers for web spectators.
+	webSpectatorUserNamePrefix = "web-spectator-"
+	// App version reported for web spectator connections.
+	webSpectatorAppVersion = "web"
+	// Maximum length in characters of a web spectator's display name.
+	maxWebSpectatorNameLength = 40
+	// Maximum size in bytes of a message received from a web spectator.
+	maxWebSpectatorMessageSize = 4096
+	// Maximum length in bytes of the reason sent when closing a web spectator connection, within the WebSocket limit
+	// for control messages.
+	maxWebSpectatorCloseReasonLength = 120
+	// Timeout for writing a message to a web spectator.
+	webSpectatorWriteTimeout = 10 * time.Second
+)
+
+// The web spectator page, which connects to the spectate endpoint and renders content with WebGL.
+//
+//go:embed spectator.html
+var spectatorHtml []byte
+
+// webSpectatorUpgrader upgrades spectate requests to WebSocket connections. Cross-origin requests are rejected.
+var webSpectatorUpgrader = websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 16384}
+
+// WebSpectator is a browser watching an anchor over a WebSocket. The server sends it the same server state updates as
+// grpc clients, as protojson text messages, and it sends its camera pose relative to the anchor as protojson
+// PoseProto text messages. Web spectators are shown to other users as desktop spectators.
+type WebSpectator struct {
+	// The generated user identifier for this spectator.
+	userName string
+	// The display name shown to other users.
+	displayName string
+	// The anchor id being watched.
+	anchorId string
+	// The WebSocket connection. Only written to by the listening loop until it exits.
+	conn *websocket.Conn
+	// Time when the WebSocket connected.
+	connectTime time.Time
+
+	// Lock to protect headPose.
+	lock sync.Mutex
+	// The latest camera pose received from the browser.
+	headPose *pb.PoseProto
+}
+
+// ServeSpectator handles an HTTP request for the web spectator page.
+func ServeSpectator(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(spectatorHtml)
+}
+
+// ServeAnchorList handles an HTTP request for the anchors that can be watched, as JSON.
+func (s *Server) ServeAnchorList(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(s.BuildDashboardStatus().Anchors)
+}
+
+// ServeModelBounds handles an HTTP request for the bounding box of a stored 3D model, so that web spectators can draw
+// models without loading them. The content hash is passed in the hash query parameter.
+func (s *Server) ServeModelBounds(w http.ResponseWriter, r *http.Request) {
+	info, ok := s.assetStore.Get(r.URL.Query().Get("hash"))
+	if !ok || info.modelInfo == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	bounds := struct {
+		Min [3]float64 `json:"min"`
+		Max [3]float64 `json:"max"`
+	}{
+		Min: [3]float64{info.modelInfo.BoundsMin.X, info.modelInfo.BoundsMin.Y, info.modelInfo.BoundsMin.Z},
+		Max: [3]float64{info.modelInfo.BoundsMax.X, info.modelInfo.BoundsMax.Y, info.modelInfo.BoundsMax.Z},
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "max-age=86400")
+	json.NewEncoder(w).Encode(bounds)
+}
+
+// ServeSpectate handles a WebSocket request from a web spectator to watch the anchor in the anchor query parameter,
+// with an optional display name in the name query parameter.
+func (s *Server) ServeSpectate(w http.ResponseWriter, r *http.Request) {
+	if atomic.LoadInt32(&s.serving) == 0 {
+		http.Error(w, "server is not serving", http.StatusServiceUnavailable)
+		return
+	}
+	anchorId := r.URL.Query().Get("anchor")
+	if anchorId == "" {
+		http.Error(w, "anchor must be set", http.StatusBadRequest)
+		return
+	}
+
+	conn, err := webSpectatorUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already replied with an error.
+		log.Printf("*** Warning: failed to upgrade web spectator connection from %v: %v", r.RemoteAddr, err)
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(maxWebSpectatorMessageSize)
+
+	spectator := &WebSpectator{
+		userName:    NewWebSpectatorUserName(),
+		displayName: SanitizeWebSpectatorName(r.URL.Query().Get("name")),
+		anchorId:    anchorId,
+		conn:        conn,
+		connectTime: time.Now(),
+	}
+	log.Printf("User %s (%s): Web spectator connected from %v to anchor %s", spectator.userName,
+		spectator.displayName, r.RemoteAddr, anchorId)
+
+	// Send a first state update so that the spectator finds the anchor, then keep sending updates so that the
+	// spectator doesn't time out until the browser disconnects.
+	if err := s.UpdateWebSpectator(spectator); err != nil {
+		spectator.Close(err)
+		return
+	}
+	readDone := make(chan bool)
+	listenDone := make(chan bool)
+	updateDone := make(chan bool)
+	go spectator.ReadCameraPoses(readDone)
+	go func() {
+		defer close(updateDone)
+		for {
+			select {
+			case <-readDone:
+				return
+			case <-listenDone:
+				return
+			case <-time.After(s.Config().ServerToClientPingInterval):
+			}
+			if err := s.UpdateWebSpectator(spectator); err != nil {
+				// The listening loop is shut down for rejected users.
+				return
+			}
+		}
+	}()
+
+	err = s.Listen(&pb.RegisterDeviceRequest{UserName: spectator.userName, AppVersion: webSpectatorAppVersion},
+		spectator.Send)
+	close(listenDone)
+	<-updateDone
+	s.RemoveWebSpectator(spectator)
+	spectator.Close(err)
+}
+
+// NewWebSpectatorUserName returns a new random user identifier for a web spectator.
+func NewWebSpectatorUserName() string {
+	id := make([]byte, 6)
+	if _, err := rand.Read(id); err != nil {
+		log.Fatalf("Failed to generate random id: %v", err)
+	}
+	return webSpectatorUserNamePrefix + hex.EncodeToString(id)
+}
+
+// SanitizeWebSpectatorName returns a display name for a web spectator without control characters and at most
+// maxWebSpectatorNameLength characters long, or a default name if the name is empty.
+func SanitizeWebSpectatorName(name string) string {
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name))
+	if runes := []rune(name); len(runes) > maxWebSpectatorNameLength {
+		name = string(runes[:maxWebSpectatorNameLength])
+	}
+	if name == "" {
+		name = "Web spectator"
+	}
+	return name
+}
+
+// UpdateWebSpectator handles a state update for a web spectator with its latest camera pose, as if sent by a desktop
+// spectator client.
+func (s *Server) UpdateWebSpectator(spectator *WebSpectator) error {
+	deviceType := pb.UserStateProto_DESKTOP_SPECTATOR
+	spectator.lock.Lock()
+	req := &pb.UpdateDeviceRequest{
+		UserState: &pb.UserStateProto{
+			UserName:        spectator.userName,
+			AnchorId:        spectator.anchorId,
+			HeadPose:        spectator.headPose,
+			UserDisplayName: spectator.displayName,
+			DeviceType:      &deviceType,
+		},
+		SpaceInfo: &pb.SpaceInfoProto{Anchor: []*pb.AnchorProto{{Id: spectator.anchorId}}},
+	}
+	spectator.lock.Unlock()
+
+	_, err := s.HandleUpdateDevice(req, spectator.connectTime)
+	return err
+}
+
+// RemoveWebSpectator removes the user state of a web spectator that has disconnected, rather than waiting for it to
+// time out.
+func (s *Server) RemoveWebSpectator(spectator *WebSpectator) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if userState, ok := s.userStateMap[spectator.userName]; ok {
+		s.RemoveUserAnchorsLocked(userState)
+		delete(s.userStateMap, spectator.userName)
+		s.PublishEventLocked(pb.ServerEventProto_USER_LEFT, spectator.userName, "", "", "disconnected")
+	}
+	log.Printf("User %s (%s): Web spectator disconnected", spectator.userName, spectator.displayName)
+}
+
+// Send sends a server state update to the browser as a protojson text message.
+func (w *WebSpectator) Send(serverStateResponse *pb.ServerStateResponse) error {
+	data, err := protojson.Marshal(serverStateResponse)
+	if err != nil {
+		return err
+	}
+	w.conn.SetWriteDeadline(time.Now().Add(webSpectatorWriteTimeout))
+	return w.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// ReadCameraPoses reads camera poses from the browser until the connection is closed or a message is invalid, then
+// closes the connection so that the listening loop stops, and closes done.
+func (w *WebSpectator) ReadCameraPoses(done chan bool) {
+	defer close(done)
+	defer w.conn.Close()
+
+	for {
+		messageType, data, err := w.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if messageType != websocket.TextMessage {
+			continue
+		}
+		headPose := &pb.PoseProto{}
+		if err := protojson.Unmarshal(data, headPose); err != nil {
+			log.Printf("User %s: *** Invalid web spectator camera pose: %v", w.userName, err)
+			return
+		}
+		w.lock.Lock()
+		w.headPose = headPose
+		w.lock.Unlock()
+	}
+}
+
+// Close closes the WebSocket, sending the browser the reason if the connection was rejected or shut down by an admin.
+func (w *WebSpectator) Close(err error) {
+	closeMessage := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err != nil {
+		closeMessage = websocket.FormatCloseMessage(websocket.ClosePolicyViolation,
+			truncateString(status.Convert(err).Message(), maxWebSpectatorCloseReasonLength))
+	}
+	w.conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(time.Second))
+	w.conn.Close()
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Maximum length in bytes for the text of a text note.
+	maxTextNoteLength = 4096
+)
+
+// HandleTextNoteAddLocked handles a text note that was added or modified by a user. s.lock must be held while calling
+// this function.
+func (s *Server) HandleTextNoteAddLocked(userName string, req *pb.TextNoteAddRequest, echo bool) {
+	if req.TextNote == nil {
+		return
+	}
+	anchorState, ok := s.anchorStateMap[req.TextNote.AnchorId]
+	if !ok || s.RejectLockedTextNoteEditLocked(anchorState, req.TextNote.Id, req.TextNote.LayerId, userName) {
+		return
+	}
+	if len(req.TextNote.Text) > maxTextNoteLength {
+		log.Printf("User %s: *** Warning: ignoring text note %s with text length %d > %d",
+			userName, req.TextNote.Id, len(req.TextNote.Text), maxTextNoteLength)
+		return
+	}
+
+	textNote := proto.Clone(req.TextNote).(*pb.TextNoteProto)
+	textNote.ModifiedByUserName = userName
+	if existingTextNote, ok := anchorState.textNotes[textNote.Id]; ok {
+		textNote.UserName = existingTextNote.UserName
+		textNote.LayerId = existingTextNote.LayerId
+	} else {
+		textNote.UserName = userName
+		anchorState.contentInfo[textNote.Id] = &ContentInfo{createdByUserName: userName, createTime: time.Now()}
+		s.PublishEventLocked(pb.ServerEventProto_TEXT_NOTE_ADDED, userName, anchorState.id, textNote.Id, "")
+	}
+	anchorState.textNotes[textNote.Id] = textNote
+
+	s.DistributeTextNoteAddLocked(anchorState, textNote.Id, userName, echo)
+	if s.verbose {
+		log.Printf("User %s: Create or update text note %s (%d characters) for anchor %s",
+			userName, textNote.Id, len(textNote.Text), anchorState.id)
+	}
+}
+
+// HandleTextNoteRemoveLocked handles a text note that was removed by a user. s.lock must be held while calling this
+// function.
+func (s *Server) HandleTextNoteRemoveLocked(userName string, req *pb.TextNoteRemoveRequest, echo bool) {
+	anchorState, ok := s.anchorStateMap[req.AnchorId]
+	if !ok || s.RejectLockedTextNoteEditLocked(anchorState, req.Id, "", userName) {
+		return
+	}
+
+	s.RemoveTextNoteLocked(anchorState, req.Id, userName, echo)
+	if s.verbose {
+		log.Printf("User %s: Removed text note %s from anchor %s", userName, req.Id, anchorState.id)
+	}
+}
+
+// RemoveTextNoteLocked removes a text note and its comments from an anchor and distributes the removal to other
+// users. s.lock must be held while calling this function.
+func (s *Server) RemoveTextNoteLocked(anchorState *AnchorState, textNoteId string, senderUserName string, echo bool) {
+	delete(anchorState.textNotes, textNoteId)
+	delete(anchorState.contentInfo, textNoteId)
+	s.RemoveContentFromGroupLocked(anchorState, textNoteId, senderUserName, echo)
+	s.RemoveContentCommentsLocked(anchorState, textNoteId, senderUserName, echo)
+	s.DistributeTextNoteRemoveLocked(anchorState, textNoteId, senderUserName, echo)
+	s.PublishEventLocked(pb.ServerEventProto_TEXT_NOTE_REMOVED, senderUserName, anchorState.id, textNoteId, "")
+}
+
+// SetTextNoteLayerLocked moves a text note to a layer and resends the text note to all users.
+// s.lock must be held while calling this function.
+func (s *Server) SetTextNoteLayerLocked(anchorState *AnchorState, textNote *pb.TextNoteProto, layerId string, senderUserName string) {
+	textNote = proto.Clone(textNote).(*pb.TextNoteProto)
+	textNote.LayerId = layerId
+	anchorState.textNotes[textNote.Id] = textNote
+	s.DistributeTextNoteAddLocked(anchorState, textNote.Id, senderUserName, true)
+}
+
+// RejectLockedTextNoteEditLocked checks whether an edit to a text note should be rejected because the text note is in
+// a locked layer, or because a new text note would be added to the locked layer newLayerId. If rejected, the user is
+// sent the server's version of the text note, or a removal if the server doesn't have it. Returns true if the edit
+// was rejected. s.lock must be held while calling this function.
+func (s *Server) RejectLockedTextNoteEditLocked(anchorState *AnchorState, textNoteId string, newLayerId string, userName string) bool {
+	if existingTextNote, ok := anchorState.textNotes[textNoteId]; ok {
+		if !s.IsLayerLockedLocked(existingTextNote.LayerId) {
+			return false
+		}
+	} else if !s.IsLayerLockedLocked(newLayerId) {
+		return false
+	}
+
+	log.Printf("User %s: *** Rejected edit to text note %s on anchor %s in a locked layer",
+		userName, textNoteId, anchorState.id)
+
+	userConnectionEntry, ok := s.userConnectionsMap[userName]
+	if !ok {
+		return true
+	}
+
+	if _, ok := anchorState.textNotes[textNoteId]; ok {
+		userConnectionEntry.notifyAboutTextNoteAdds[textNoteId] = anchorState.id
+	} else {
+		userConnectionEntry.notifyAboutTextNoteRemovals[textNoteId] = anchorState.id
+	}
+	select {
+	case userConnectionEntry.wakeUp <- true:
+	default:
+	}
+	return true
+}
+
+// DistributeTextNoteAddLocked sets notification bits for user connections, for a text note that was added or
+// modified. s.lock must be held while calling this function.
+func (s *Server) DistributeTextNoteAddLocked(anchorState *AnchorState, textNoteId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutTextNoteRemovals, textNoteId)
+			userConnectionEntry.notifyAboutTextNoteAdds[textNoteId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeTextNoteRemoveLocked sets notification bits for user connections, for a text note that was removed.
+// s.lock must be held while calling this function.
+func (s *Server) DistributeTextNoteRemoveLocked(anchorState *AnchorState, textNoteId string, senderUserName string, echo bool) {
+	for userToNotify := range anchorState.userSet {
+		if userToNotify == senderUserName && !echo {
+			continue
+		}
+
+		if userConnectionEntry, ok := s.userConnectionsMap[userToNotify]; ok {
+			delete(userConnectionEntry.notifyAboutTextNoteAdds, textNoteId)
+			userConnectionEntry.notifyAboutTextNoteRemovals[textNoteId] = anchorState.id
+			select {
+			case userConnectionEntry.wakeUp <- true:
+			default:
+			}
+		}
+	}
+}
+
+// DistributeMissingTextNotesToUserLocked sets notify bits for all text notes that a user hasn't been notified about
+// yet. s.lock must be held while calling this function.
+func (s *Server) DistributeMissingTextNotesToUserLocked(userStateEntry *UserState, userConnectionState *UserConnectionState) {
+	if userStateEntry == nil {
+		userStateEntry = s.userStateMap[userConnectionState.userName]
+	}
+	if userConnectionState == nil {
+		userConnectionState = s.userConnectionsMap[userStateEntry.userName]
+	}
+	if userStateEntry == nil || userConnectionState == nil || userStateEntry.spaceInfoProto == nil {
+		return
+	}
+
+	for _, anchor := range userStateEntry.spaceInfoProto.Anchor {
+		if anchorState, ok := s.anchorStateMap[anchor.Id]; ok {
+			for textNoteId := range anchorState.textNotes {
+				userConnectionState.notifyAboutTextNoteAdds[textNoteId] = anchor.Id
+			}
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "gitlab.magicleap.io/ghazen/leap-brush/server/api"
+)
+
+const (
+	// Default maximum time to wait to collect a batch of events for each webhook.
+	defaultWebhookBatchInterval = time.Second
+	// Default maximum number of events sent in each webhook request.
+	defaultWebhookMaxBatchSize = 100
+	// Default number of events queued for each webhook before further events are dropped.
+	defaultWebhookQueueSize = 1000
+	// Default number of times a webhook request is sent before its events are dropped.
+	defaultWebhookMaxAttempts = 5
+
+	// Timeout for each webhook request.
+	webhookRequestTimeout = 10 * time.Second
+	// Delay before retrying a failed webhook request, doubled after each further failure up to webhookMaxRetryDelay.
+	webhookInitialRetryDelay = time.Second
+	webhookMaxRetryDelay     = 30 * time.Second
+
+	// Header containing the HMAC-SHA256 signature of a webhook request body.
+	webhookSignatureHeader = "X-LeapBrush-Signature"
+)
+
+var (
+	// The event types sent to webhooks.
+	webhookEventTypes = map[pb.ServerEventProto_Type]bool{
+		pb.ServerEventProto_USER_JOINED:            true,
+		pb.ServerEventProto_USER_LEFT:              true,
+		pb.ServerEventProto_BRUSH_STROKE_ADDED:     true,
+		pb.ServerEventProto_BRUSH_STROKE_REMOVED:   true,
+		pb.ServerEventProto_EXTERNAL_MODEL_ADDED:   true,
+		pb.ServerEventProto_EXTERNAL_MODEL_REMOVED: true,
+		pb.ServerEventProto_BATTERY_LOW:            true,
+	}
+
+	// Options for encoding webhook requests, with field names matching the .proto file.
+	webhookMarshalOptions = protojson.MarshalOptions{UseProtoNames: true}
+)
+
+// WebhookDispatcher subscribes to server events and sends them to the configured webhook urls. Each url has its own
+// bounded queue and sending goroutine, so that a slow or failing url neither delays other urls nor blocks the server.
+type WebhookDispatcher struct {
+	server     *Server
+	subscriber *EventSubscriber
+	client     *http.Client
+
+	// Context for webhook requests, cancelled to abandon requests and retries when the server has finished shutting
+	// down.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// Map of senders for the configured urls. Key is the url. Only accessed by the dispatching goroutine.
+	senders map[string]*WebhookSender
+	// Wait group for the sending goroutines.
+	sendersDone sync.WaitGroup
+	// Closed once every sender has sent its queued events.
+	done chan bool
+}
+
+// WebhookSender sends batches of events to a single webhook url.
+type WebhookSender struct {
+	url string
+	// The queue of events to send. Closed when the url is removed from the config or the server shuts down.
+	queue chan *pb.ServerEventProto
+	// Number of events dropped since the last batch was sent. Updated atomically.
+	numDropped int32
+}
+
+// StartWebhooks starts sending server events to the webhook urls in the server's config. The urls are checked on
+// every event, so that config reloads add and remove urls. Sending stops after the server shuts down and the queued
+// events have been sent.
+func StartWebhooks(s *Server) *WebhookDispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &WebhookDispatcher{
+		server:     s,
+		subscriber: s.SubscribeEvents("webhooks"),
+		client:     &http.Client{Timeout: webhookRequestTimeout},
+		ctx:        ctx,
+		cancel:     cancel,
+		senders:    make(map[string]*WebhookSender),
+		done:       make(chan bool),
+	}
+	d.UpdateSenders()
+	go d.Dispatch()
+	return d
+}
+
+// Stop waits until deadline for the queued events to be sent, then abandons any remaining requests. The server must
+// have been shut down before calling this function.
+func (d *WebhookDispatcher) Stop(deadline time.Time) {
+	select {
+	case <-d.done:
+	case <-time.After(time.Until(deadline)):
+		log.Printf("*** Warning: timed out sending queued webhook events")
+	}
+	d.cancel()
+	<-d.done
+}
+
+// Dispatch queues each event for the webhook senders until the server shuts down.
+func (d *WebhookDispatcher) Dispatch() {
+	defer close(d.done)
+
+	for event := range d.subscriber.events {
+		if !webhookEventTypes[event.Type] {
+			continue
+		}
+		d.UpdateSenders()
+		for _, sender := range d.senders {
+			sender.Enqueue(event)
+		}
+	}
+
+	for _, sender := range d.senders {
+		close(sender.queue)
+	}
+	d.sendersDone.Wait()
+}
+
+// UpdateSenders starts senders for webhook urls added to the config, and stops senders for urls removed from the
+// config after they have sent their queued events.
+func (d *WebhookDispatcher) UpdateSenders() {
+	config := d.server.Config()
+
+	urls := make(map[string]bool)
+	for _, url := range config.WebhookUrls {
+		urls[url] = true
+		if _, ok := d.senders[url]; ok {
+			continue
+		}
+		sender := &WebhookSender{url: url, queue: make(chan *pb.ServerEventProto, config.WebhookQueueSize)}
+		d.senders[url] = sender
+		d.sendersDone.Add(1)
+		go func() {
+			defer d.sendersDone.Done()
+			d.SendBatches(sender)
+		}()
+		log.Printf("Sending events to webhook %s", url)
+	}
+	for url, sender := range d.senders {
+		if !urls[url] {
+			close(sender.queue)
+			delete(d.senders, url)
+			log.Printf("Stopped sending events to webhook %s", url)
+		}
+	}
+}
+
+// Enqueue adds an event to the sender's queue without blocking, dropping the event if the queue is full.
+func (w *WebhookSender) Enqueue(event *pb.ServerEventProto) {
+	select {
+	case w.queue <- event:
+	default:
+		atomic.AddInt32(&w.numDropped, 1)
+	}
+}
+
+// SendBatches collects queued events into batches and sends them until the sender's queue is closed and empty.
+func (d *WebhookDispatcher) SendBatches(sender *WebhookSender) {
+	for {
+		event, ok := <-sender.queue
+		if !ok {
+			return
+		}
+
+		config := d.server.Config()
+		batch := []*pb.ServerEventProto{event}
+		batchTimer := time.NewTimer(config.WebhookBatchInterval)
+	collect:
+		for len(batch) < config.WebhookMaxBatchSize {
+			select {
+			case event, ok := <-sender.queue:
+				if !ok {
+					break collect
+				}
+				batch = append(batch, event)
+			case <-batchTimer.C:
+				break collect
+			}
+		}
+		batchTimer.Stop()
+
+		d.SendBatch(sender, batch)
+	}
+}
+
+// SendBatch sends a batch of events to a webhook url, retrying with backoff until the request succeeds, fails with
+// an error that retrying won't fix, or has been sent config.WebhookMaxAttempts times.
+func (d *WebhookDispatcher) SendBatch(sender *WebhookSender, batch []*pb.ServerEventProto) {
+	config := d.server.Config()
+	req := &pb.WebhookRequestProto{
+		BatchId:       NewWebhookBatchId(),
+		ServerVersion: serverVersion,
+		Events:        batch,
+		NumDropped:    atomic.SwapInt32(&sender.numDropped, 0),
+	}
+	if req.NumDropped > 0 {
+		log.Printf("*** Warning: dropped %d events for slow webhook %s", req.NumDropped, sender.url)
+		atomic.AddUint64(&d.server.metrics.webhookEventsDropped, uint64(req.NumDropped))
+	}
+	body, err := webhookMarshalOptions.Marshal(req)
+	if err != nil {
+		log.Printf("*** Warning: failed to encode webhook request: %v", err)
+		return
+	}
+
+	retryDelay := webhookInitialRetryDelay
+	for attempt := 1; ; attempt++ {
+		retry, err := d.Post(sender.url, body, config.WebhookSecret)
+		if err == nil {
+			atomic.AddUint64(&d.server.metrics.webhookEventsSent, uint64(len(batch)))
+			if config.Verbose {
+				log.Printf("Sent %d events to webhook %s", len(batch), sender.url)
+			}
+			return
+		}
+		atomic.AddUint64(&d.server.metrics.webhookFailures, 1)
+		if !retry || attempt >= config.WebhookMaxAttempts {
+			log.Printf("*** Warning: dropped %d events after %d failed attempts to send to webhook %s: %v",
+				len(batch), attempt, sender.url, err)
+			atomic.AddUint64(&d.server.metrics.webhookEventsDropped, uint64(len(batch)))
+			return
+		}
+		log.Printf("*** Warning: failed to send to webhook %s, retrying in %v: %v", sender.url, retryDelay, err)
+
+		select {
+		case <-time.After(retryDelay):
+		case <-d.ctx.Done():
+			log.Printf("*** Warning: dropped %d events for webhook %s on shutdown", len(batch), sender.url)
+			atomic.AddUint64(&d.server.metrics.webhookEventsDropped, uint64(len(batch)))
+			return
+		}
+		retryDelay *= 2
+		if retryDelay > webhookMaxRetryDelay {
+			retryDelay = webhookMaxRetryDelay
+		}
+	}
+}
+
+// Post sends a webhook request body to url, signed with secret if not empty. Returns whether the request can be
+// retried if it failed.
+func (d *WebhookDispatcher) Post(url string, body []byte, secret string) (bool, error) {
+	httpReq, err := http.NewRequestWithContext(d.ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "leapbrush-server/"+serverVersion)
+	if secret != "" {
+		httpReq.Header.Set(webhookSignatureHeader, SignWebhookBody(body, secret))
+	}
+
+	resp, err := d.client.Do(httpReq)
+	if err != nil {
+		return d.ctx.Err() == nil, err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	// Retry server errors and rate limiting. Other client errors won't be fixed by sending the same request again.
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusRequestTimeout ||
+		resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("http status %s", resp.Status)
+}
+
+// SignWebhookBody returns the X-LeapBrush-Signature header value for a webhook request body: "sha256=" followed by
+// the hex HMAC-SHA256 of the body keyed with secret.
+func SignWebhookBody(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewWebhookBatchId returns a new random identifier for a batch of webhook events.
+func NewWebhookBatchId() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		log.Fatalf("Failed to generate random id: %v", err)
+	}
+	return hex.EncodeToString(id)
+}
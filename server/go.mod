@@ -3,8 +3,10 @@ module gitlab.magicleap.io/ghazen/leap-brush/server
 go 1.17
 
 require (
+	github.com/gorilla/websocket v1.5.0
 	google.golang.org/grpc v1.46.0
 	google.golang.org/protobuf v1.28.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (